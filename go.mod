@@ -5,6 +5,5 @@ go 1.13
 require (
 	github.com/gojuno/minimock/v3 v3.0.6
 	github.com/mailru/easyjson v0.7.0
-	github.com/pkg/errors v0.9.1
 	github.com/valyala/fasthttp v1.8.0
 )
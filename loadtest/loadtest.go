@@ -0,0 +1,163 @@
+// Package loadtest drives a constant rate of SDK calls against a target, e.g. a mock or staging,
+// and reports latency percentiles, allocations and error rate, so SDK performance regressions are measurable.
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// Call is a single SDK call, e.g. a reverse geocode request.
+type Call func(ctx context.Context) error
+
+// Config tunes load test.
+type Config struct {
+	// QPS is a rate calls are started at.
+	QPS int
+	// Duration of the test.
+	Duration time.Duration
+	// MaxInFlight limits concurrent calls, default to QPS.
+	// Calls which could not be started in time are counted as dropped.
+	MaxInFlight int
+}
+
+// Report summarizes load test results.
+type Report struct {
+	Calls   int
+	Errors  int
+	Dropped int
+
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+	Max time.Duration
+
+	// AllocsPerCall and BytesPerCall are process wide heap allocations divided by calls.
+	AllocsPerCall uint64
+	BytesPerCall  uint64
+}
+
+// ErrorRate returns share of failed calls from 0 to 1.
+func (r *Report) ErrorRate() float64 {
+	if r.Calls == 0 {
+		return 0
+	}
+	return float64(r.Errors) / float64(r.Calls)
+}
+
+// Run starts call cfg.QPS times a second for cfg.Duration and waits for all calls to finish.
+func Run(ctx context.Context, cfg Config, call Call) (*Report, error) {
+	if cfg.QPS <= 0 || cfg.Duration <= 0 {
+		return nil, fmt.Errorf("invalid qps %d or duration %s", cfg.QPS, cfg.Duration)
+	}
+	if cfg.MaxInFlight <= 0 {
+		cfg.MaxInFlight = cfg.QPS
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Duration)
+	defer cancel()
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		latencies []time.Duration
+		report    Report
+	)
+	sem := make(chan struct{}, cfg.MaxInFlight)
+
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	ticker := time.NewTicker(time.Second / time.Duration(cfg.QPS))
+	defer ticker.Stop()
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case <-ticker.C:
+		}
+
+		select {
+		case sem <- struct{}{}:
+		default:
+			report.Dropped++
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			started := time.Now()
+			err := call(context.Background())
+			latency := time.Since(started)
+
+			mu.Lock()
+			defer mu.Unlock()
+			latencies = append(latencies, latency)
+			if err != nil {
+				report.Errors++
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+
+	report.Calls = len(latencies)
+	if report.Calls == 0 {
+		return &report, nil
+	}
+
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+	report.P50 = percentile(latencies, 0.5)
+	report.P90 = percentile(latencies, 0.9)
+	report.P99 = percentile(latencies, 0.99)
+	report.Max = latencies[len(latencies)-1]
+
+	report.AllocsPerCall = (after.Mallocs - before.Mallocs) / uint64(report.Calls)
+	report.BytesPerCall = (after.TotalAlloc - before.TotalAlloc) / uint64(report.Calls)
+
+	return &report, nil
+}
+
+// percentile returns p-th percentile of sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// MockClient is a fasthttp client answering every request with body after latency,
+// it could be passed to SDK with mapbox.HttpClient option to load test the SDK itself.
+type MockClient struct {
+	Body    []byte
+	Latency time.Duration
+}
+
+// Do implements mapbox.FastHttpClient.
+func (c *MockClient) Do(_ *fasthttp.Request, resp *fasthttp.Response) error {
+	if c.Latency > 0 {
+		time.Sleep(c.Latency)
+	}
+	resp.SetBody(c.Body)
+	return nil
+}
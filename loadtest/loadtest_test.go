@@ -0,0 +1,41 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/humans-net/mapbox-sdk-go/mapbox"
+)
+
+func TestRun(t *testing.T) {
+	g := mapbox.NewFastHttpGeocoder(mapbox.HttpClient(&MockClient{
+		Body:    []byte(`{"type":"FeatureCollection","query":[1,2],"features":[]}`),
+		Latency: time.Millisecond,
+	}))
+
+	var n int32
+	report, err := Run(context.Background(), Config{QPS: 200, Duration: 100 * time.Millisecond}, func(ctx context.Context) error {
+		if atomic.AddInt32(&n, 1)%2 == 0 {
+			return errors.New("failed")
+		}
+		_, err := g.ReverseGeocode(ctx, &mapbox.ReverseGeocodeRequest{GeoPoint: mapbox.GeoPoint{Lon: 1, Lat: 2}})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Calls == 0 || report.Errors != report.Calls/2 {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if report.P50 > report.P99 || report.P99 > report.Max || report.AllocsPerCall == 0 {
+		t.Errorf("unexpected stats %+v", report)
+	}
+
+	if _, err := Run(context.Background(), Config{}, nil); err == nil {
+		t.Error("config error expected")
+	}
+}
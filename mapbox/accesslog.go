@@ -0,0 +1,74 @@
+package mapbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"hash/fnv"
+	"time"
+)
+
+// AccessRecord summarizes a single mapbox API call.
+type AccessRecord struct {
+	// Endpoint is the SDK operation, e.g. reverse geocode.
+	Endpoint string
+	// ParamsHash is a hex fnv-1a hash of the request URI without access token.
+	// Equal requests have equal hashes, so it could be used to spot repeated calls.
+	ParamsHash string
+	// StatusCode is 0 if no response was received.
+	StatusCode int
+	Duration   time.Duration
+	// Bytes is the response body size.
+	Bytes int
+	// RateLimit as reported by mapbox, it has no remaining counter, only limit, interval and reset.
+	RateLimit RateLimit
+	// RequestID is the mapbox request id, see Meta.RequestID.
+	RequestID string
+	// Err is a transport error, non 2xx responses are reported with StatusCode only.
+	Err error
+	// Labels are attached to call context with WithContextLabels, they must not be modified.
+	Labels map[string]string
+}
+
+// AccessLog sets a hook called once per API call, e.g. to write structured access logs.
+func AccessLog(hook func(ctx context.Context, r AccessRecord)) Option {
+	return func(c config) config {
+		c.accessLog = hook
+		return c
+	}
+}
+
+// logAccess calls access log hook if set.
+func (c *config) logAccess(ctx context.Context, op string, reqURI []byte, started time.Time, resp *rawResponse, err error) {
+	if c.accessLog == nil {
+		return
+	}
+
+	r := AccessRecord{
+		Endpoint:   op,
+		ParamsHash: c.paramsHash(reqURI),
+		Duration:   c.clock.Now().Sub(started),
+		Err:        err,
+		Labels:     contextLabels(ctx),
+	}
+	if resp != nil {
+		r.StatusCode = resp.statusCode
+		r.Bytes = len(resp.body)
+		r.RateLimit = resp.rateLimit
+		r.RequestID = resp.meta.RequestID
+	}
+
+	c.accessLog(ctx, r)
+}
+
+// paramsHash hashes request URI skipping access token.
+func (c *config) paramsHash(reqURI []byte) string {
+	h := fnv.New64a()
+	if i := bytes.Index(reqURI, c.accessTokenGetValue); i >= 0 && len(c.accessTokenGetValue) > 0 {
+		h.Write(reqURI[:i])
+		h.Write(reqURI[i+len(c.accessTokenGetValue):])
+	} else {
+		h.Write(reqURI)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,50 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func Test_config_logAccess(t *testing.T) {
+	var records []AccessRecord
+
+	c := NewFastHttpGeocoder(
+		AccessToken("token"),
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.Header.Set(respHeaderRateLimitLimit, "600")
+			resp.SetBody(testRespBody)
+			return nil
+		})),
+		AccessLog(func(_ context.Context, r AccessRecord) {
+			records = append(records, r)
+		}),
+	)
+
+	ctx := WithContextLabels(WithContextLabels(context.Background(),
+		map[string]string{"tenant": "a", "product": "search"}), map[string]string{"tenant": "b"})
+
+	req := &ReverseGeocodeRequest{GeoPoint: GeoPoint{Lon: -77.036547, Lat: 38.897675}}
+	if _, err := c.ReverseGeocode(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	other := NewFastHttpGeocoder(AccessToken("other"), HttpClient(c.client), AccessLog(c.accessLog))
+	if _, err := other.ReverseGeocode(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("unexpected records %v", records)
+	}
+
+	r := records[0]
+	if r.Endpoint != "reverse geocode" || r.StatusCode != fasthttp.StatusOK || r.Bytes != len(testRespBody) ||
+		string(r.RateLimit.Limit) != "600" || r.Err != nil || r.Labels["tenant"] != "b" || r.Labels["product"] != "search" {
+		t.Errorf("unexpected record %+v", r)
+	}
+	if r.ParamsHash == "" || r.ParamsHash != records[1].ParamsHash {
+		t.Errorf("params hash should not depend on access token: %s %s", r.ParamsHash, records[1].ParamsHash)
+	}
+}
@@ -0,0 +1,40 @@
+package mapbox
+
+// Accuracy is a precision of address feature point.
+type Accuracy string
+
+const (
+	// AccuracyRooftop is a point on the building.
+	AccuracyRooftop Accuracy = "rooftop"
+	// AccuracyParcel is a point on the parcel of land.
+	AccuracyParcel Accuracy = "parcel"
+	// AccuracyPoint is a point sourced from an address point dataset.
+	AccuracyPoint Accuracy = "point"
+	// AccuracyInterpolated is a point interpolated along the street address range.
+	AccuracyInterpolated Accuracy = "interpolated"
+	// AccuracyApproximate is a point which is not precise.
+	AccuracyApproximate Accuracy = "approximate"
+	// AccuracyStreet is a street centroid.
+	AccuracyStreet Accuracy = "street"
+)
+
+// accuracyRanks orders accuracies from the least precise, unknown accuracies rank 0.
+var accuracyRanks = map[Accuracy]int{
+	AccuracyStreet:       1,
+	AccuracyApproximate:  2,
+	AccuracyInterpolated: 3,
+	AccuracyPoint:        4,
+	AccuracyParcel:       5,
+	AccuracyRooftop:      6,
+}
+
+// Known returns false for empty or unknown accuracy, e.g. of non address features.
+func (a Accuracy) Known() bool {
+	return accuracyRanks[a] > 0
+}
+
+// IsAtLeast returns true if a is as precise as min or more, e.g. rooftop is at least parcel.
+// Unknown accuracy is never at least a known one.
+func (a Accuracy) IsAtLeast(min Accuracy) bool {
+	return accuracyRanks[a] >= accuracyRanks[min]
+}
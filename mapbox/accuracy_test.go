@@ -0,0 +1,26 @@
+package mapbox
+
+import "testing"
+
+func TestAccuracy_IsAtLeast(t *testing.T) {
+	tests := []struct {
+		a, min Accuracy
+		want   bool
+	}{
+		{a: AccuracyRooftop, min: AccuracyParcel, want: true},
+		{a: AccuracyParcel, min: AccuracyParcel, want: true},
+		{a: AccuracyInterpolated, min: AccuracyPoint, want: false},
+		{a: AccuracyStreet, min: AccuracyApproximate, want: false},
+		{a: "", min: AccuracyStreet, want: false},
+		{a: "intersection", min: AccuracyStreet, want: false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.IsAtLeast(tt.min); got != tt.want {
+			t.Errorf("%q.IsAtLeast(%q) = %v, want %v", tt.a, tt.min, got, tt.want)
+		}
+	}
+
+	if Accuracy("").Known() || !AccuracyStreet.Known() {
+		t.Error("unexpected Known result")
+	}
+}
@@ -0,0 +1,110 @@
+package mapbox
+
+import (
+	"strings"
+)
+
+// PreprocessQuery sets a function applied to forward geocode search text before it is sent,
+// e.g. NormalizeAddress to improve match rates of messy user input.
+func PreprocessQuery(p func(text string) string) Option {
+	return func(c config) config {
+		c.preprocessQuery = p
+		return c
+	}
+}
+
+// unitDesignators prefix apartment or unit numbers, they are stripped with the following token.
+var unitDesignators = map[string]bool{
+	"apt":       true,
+	"apartment": true,
+	"unit":      true,
+	"suite":     true,
+	"ste":       true,
+	"room":      true,
+	"rm":        true,
+	"flat":      true,
+	"#":         true,
+}
+
+// streetTypes are expanded to full words as mapbox address format guide recommends.
+// They are expanded only at the end of street name, e.g. St Louis is kept.
+var streetTypes = map[string]string{
+	"st":   "Street",
+	"ave":  "Avenue",
+	"av":   "Avenue",
+	"rd":   "Road",
+	"blvd": "Boulevard",
+	"dr":   "Drive",
+	"ln":   "Lane",
+	"ct":   "Court",
+	"pl":   "Place",
+	"sq":   "Square",
+	"hwy":  "Highway",
+	"pkwy": "Parkway",
+	"cir":  "Circle",
+	"ter":  "Terrace",
+}
+
+// directions are expanded unless they are the whole address part, e.g. a state.
+var directions = map[string]string{
+	"n":  "North",
+	"s":  "South",
+	"e":  "East",
+	"w":  "West",
+	"ne": "Northeast",
+	"nw": "Northwest",
+	"se": "Southeast",
+	"sw": "Southwest",
+}
+
+// NormalizeAddress prepares free form address for forward geocoding:
+// collapses whitespace, drops periods, replaces forbidden semicolons with commas,
+// strips apartment and unit numbers and expands street type and direction abbreviations.
+func NormalizeAddress(text string) string {
+	text = strings.Replace(text, ";", ",", -1)
+	text = strings.Replace(text, ".", "", -1)
+
+	parts := strings.Split(text, ",")
+	normalized := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = normalizeAddressPart(p); p != "" {
+			normalized = append(normalized, p)
+		}
+	}
+
+	return strings.Join(normalized, ", ")
+}
+
+func normalizeAddressPart(part string) string {
+	tokens := strings.Fields(part)
+
+	kept := tokens[:0]
+	for i := 0; i < len(tokens); i++ {
+		lower := strings.ToLower(tokens[i])
+		switch {
+		case unitDesignators[lower]:
+			i++ // skip unit number too
+		case strings.HasPrefix(lower, "#"):
+		default:
+			kept = append(kept, tokens[i])
+		}
+	}
+
+	if len(kept) < 2 {
+		return strings.Join(kept, " ")
+	}
+
+	for i, t := range kept {
+		lower := strings.ToLower(t)
+		if d, ok := directions[lower]; ok {
+			kept[i] = d
+			continue
+		}
+		last := i == len(kept)-1 || directions[strings.ToLower(kept[i+1])] != ""
+		if st, ok := streetTypes[lower]; ok && last && i > 0 {
+			kept[i] = st
+		}
+	}
+
+	return strings.Join(kept, " ")
+}
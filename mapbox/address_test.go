@@ -0,0 +1,43 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "  123  Main St.  Apt 4B,Springfield ;IL ", want: "123 Main Street, Springfield, IL"},
+		{in: "2 Lincoln Memorial Cir SW #12, Washington", want: "2 Lincoln Memorial Circle Southwest, Washington"},
+		{in: "500 N. Pkwy Suite 200", want: "500 North Parkway"},
+		{in: ",, Paris ,", want: "Paris"},
+		{in: "10 St Louis Ave E, St Louis, MO", want: "10 St Louis Avenue East, St Louis, MO"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeAddress(tt.in); got != tt.want {
+			t.Errorf("NormalizeAddress(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPreprocessQuery(t *testing.T) {
+	var uri string
+	g := NewFastHttpGeocoder(PreprocessQuery(NormalizeAddress), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["a"],"features":[]}`)
+			return nil
+		})))
+
+	if _, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "1 Main St. Apt 2"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(uri, "/1%20Main%20Street.json") {
+		t.Errorf("search text is not preprocessed %s", uri)
+	}
+}
@@ -0,0 +1,165 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MaxBatchReverseGeocodeQueries is the maximum number of coordinates in a single v5 batch request.
+const MaxBatchReverseGeocodeQueries = 50
+
+// BatchReverseGeocodeRequest describes geocode/v5 batch reverse request, params apply to every point.
+type BatchReverseGeocodeRequest struct {
+	GeoPoints []GeoPoint
+	// Limit is the maximum number of results per point.
+	Limit int
+	// Types filters results to a subset of feature types, see ReverseGeocodeRequest.Types.
+	Types []string
+	// Country limits results to ISO 3166 alpha 2 country codes separated by commas.
+	Country string
+	// Language of the returned text, default to language set with WithContextLanguage.
+	Language string
+	// ReverseMode 1 sorts results by score instead of distance.
+	ReverseMode int
+	// Routing requests routable points of address features.
+	Routing bool
+	// Worldview returns features for disputed boundaries as seen from the country: cn, in, jp or us, default us.
+	Worldview string
+}
+
+// easyjson:json
+type rawBatchReverseGeoResp []rawReverseGeoResp
+
+// BatchReverseGeocodeResponse wraps v5 batch reverse results.
+type BatchReverseGeocodeResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	// Results are features of every point in request order.
+	Results [][]Feature
+	// Permanent results could be stored.
+	Permanent bool
+
+	config *config
+}
+
+// Retain returns raw response to store, see StrictStorage.
+func (r *BatchReverseGeocodeResponse) Retain() ([]byte, error) {
+	return r.config.retain(r.Permanent, r.RawResp)
+}
+
+// BatchReverseGeocode calls geocode/v5 mapbox API with up to MaxBatchReverseGeocodeQueries
+// semicolon separated points in a single request. Batch geocoding is available on the permanent endpoint only,
+// so it requires Permanent option and it is never downgraded by PermanentFallback.
+// Features are decoded as is, without SoftFailDecoding, ReuseContexts and FilterFeatures.
+func (c *FastHttpGeocoder) BatchReverseGeocode(ctx context.Context, req *BatchReverseGeocodeRequest, opts ...CallOption) (*BatchReverseGeocodeResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if c.geocodeEndpoint != PlacesPermanent {
+		return nil, errors.New("batch geocoding requires permanent endpoint, set it with Permanent option")
+	}
+	if len(req.GeoPoints) == 0 {
+		return nil, validationErrorf("GeoPoints", ConstraintMinItems, "batch must have from 1 to %d points, got %d",
+			MaxBatchReverseGeocodeQueries, len(req.GeoPoints))
+	}
+	if len(req.GeoPoints) > MaxBatchReverseGeocodeQueries {
+		return nil, validationErrorf("GeoPoints", ConstraintMaxItems, "batch must have from 1 to %d points, got %d",
+			MaxBatchReverseGeocodeQueries, len(req.GeoPoints))
+	}
+	for i, p := range req.GeoPoints {
+		if !validCoordinate(p.Lon, 180) || !validCoordinate(p.Lat, 90) {
+			return nil, validationErrorf(indexField("GeoPoints", i), ConstraintRange, "invalid point %d: %v,%v", i, p.Lon, p.Lat)
+		}
+	}
+
+	// split multivalues to limit memory consumption
+	values := make(map[string]string, 6)
+
+	if req.Country != "" {
+		values[country] = req.Country
+	}
+	if req.Limit != 0 {
+		values[limit] = strconv.Itoa(req.Limit)
+	}
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
+	}
+	if req.Routing {
+		values[routing] = trueStr
+	}
+	if req.ReverseMode == 1 {
+		values[reverseMode] = oneStr
+	}
+	if len(req.Types) > 0 {
+		values[types] = strings.Join(req.Types, ",")
+	}
+	if req.Worldview != "" {
+		values[worldview] = req.Worldview
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	buf.Write(c.geocodeAPIURL)
+	for i, p := range req.GeoPoints {
+		if i > 0 {
+			buf.WriteByte(';')
+		}
+		buf.WriteString(p.String())
+	}
+	buf.Write(responseFormatJSON)
+	buf.Write(c.accessTokenGetValue)
+
+	encodeValues(buf, values)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "batch reverse geocode", reqURI, values,
+		logKeyEndpoint, string(c.geocodeEndpoint), logKeyBatchSize, strconv.Itoa(len(req.GeoPoints)))
+
+	resp, err := c.do(ctx, "batch reverse geocode", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "batch reverse geocode", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("batch reverse geocode", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawBatchReverseGeoResp{}
+	if len(req.GeoPoints) == 1 {
+		// mapbox responds with a single feature collection, not an array, to a batch of one
+		respRaw = append(respRaw, rawReverseGeoResp{})
+		err = c.decode(resp, &respRaw[0])
+	} else {
+		err = c.decode(resp, &respRaw)
+	}
+	if err != nil {
+		return nil, errorf("failed to unmarshall raw batch reverse geocode resp %s: %w", string(resp.body), err)
+	}
+	if len(respRaw) != len(req.GeoPoints) {
+		return nil, errorf("unexpected batch reverse geocode results count %d, want %d", len(respRaw), len(req.GeoPoints))
+	}
+
+	results := make([][]Feature, len(respRaw))
+	for i := range respRaw {
+		results[i] = respRaw[i].Features
+	}
+
+	return &BatchReverseGeocodeResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Results:   results,
+		Permanent: true,
+		config:    &c.config,
+	}, nil
+}
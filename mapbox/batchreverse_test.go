@@ -0,0 +1,62 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpGeocoder_BatchReverseGeocode(t *testing.T) {
+	var gotURI string
+	body := `[{"query":[1,2],"features":[{"id":"place.1"}]},{"query":[3,4],"features":[{"id":"place.2"},{"id":"region.3"}]}]`
+	g := NewFastHttpGeocoder(AccessToken("token"), Permanent(true), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			gotURI = string(req.RequestURI())
+			if !strings.Contains(gotURI, ";") {
+				resp.SetBodyString(`{"query":[1,2],"features":[{"id":"place.1"}]}`)
+				return nil
+			}
+			resp.SetBodyString(body)
+			return nil
+		})))
+
+	resp, err := g.BatchReverseGeocode(context.Background(), &BatchReverseGeocodeRequest{
+		GeoPoints: []GeoPoint{{Lon: 1, Lat: 2}, {Lon: 3, Lat: 4}},
+		Types:     []string{"place", "region"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://api.mapbox.com/geocoding/v5/mapbox.places-permanent/1.000000,2.000000;3.000000,4.000000.json?access_token=token&types=place,region"; gotURI != want {
+		t.Errorf("URI = %s, want %s", gotURI, want)
+	}
+	if len(resp.Results) != 2 || len(resp.Results[0]) != 1 || len(resp.Results[1]) != 2 || resp.Results[1][1].ID != "region.3" || !resp.Permanent {
+		t.Errorf("unexpected results %+v", resp.Results)
+	}
+
+	resp, err = g.BatchReverseGeocode(context.Background(), &BatchReverseGeocodeRequest{GeoPoints: []GeoPoint{{Lon: 1, Lat: 2}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Results) != 1 || len(resp.Results[0]) != 1 || resp.Results[0][0].ID != "place.1" {
+		t.Errorf("unexpected single point results %+v", resp.Results)
+	}
+
+	invalid := []*BatchReverseGeocodeRequest{
+		{},
+		{GeoPoints: make([]GeoPoint, MaxBatchReverseGeocodeQueries+1)},
+		{GeoPoints: []GeoPoint{{Lon: 1, Lat: 2}, {Lon: 1, Lat: 91}}},
+	}
+	for _, req := range invalid {
+		if _, err := g.BatchReverseGeocode(context.Background(), req); err == nil {
+			t.Errorf("BatchReverseGeocode(%d points) error expected", len(req.GeoPoints))
+		}
+	}
+
+	temporary := NewFastHttpGeocoder(AccessToken("token"))
+	if _, err := temporary.BatchReverseGeocode(context.Background(), &BatchReverseGeocodeRequest{GeoPoints: []GeoPoint{{}}}); err == nil {
+		t.Error("permanent endpoint error expected")
+	}
+}
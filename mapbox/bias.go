@@ -0,0 +1,84 @@
+package mapbox
+
+import (
+	"strings"
+)
+
+// BiasProfile tunes forward geocoding of a market, e.g. loaded from configuration.
+// Profile fields are used if request ones are empty.
+type BiasProfile struct {
+	// Country is a comma separated list of ISO 3166 alpha 2 country codes.
+	Country   string
+	Proximity *GeoPoint
+	Types     []string
+	Language  string
+	// StrictCountry drops features outside Country, e.g. of other countries matched by name.
+	// Dropped features are counted in GeocodeResponse.Filtered.
+	StrictCountry bool
+}
+
+// BiasProfiles sets named profiles forward geocode requests select with BiasProfile field.
+func BiasProfiles(profiles map[string]BiasProfile) Option {
+	return func(c config) config {
+		c.biasProfiles = make(map[string]BiasProfile, len(profiles))
+		for k, v := range profiles {
+			c.biasProfiles[k] = v
+		}
+		return c
+	}
+}
+
+// biasProfile returns named profile, zero profile is returned for empty name.
+func (c *config) biasProfile(name string) (BiasProfile, error) {
+	if name == "" {
+		return BiasProfile{}, nil
+	}
+	p, ok := c.biasProfiles[name]
+	if !ok {
+		return BiasProfile{}, validationErrorf("BiasProfile", ConstraintEnum, "unknown bias profile %q", name)
+	}
+	return p, nil
+}
+
+// apply returns a copy of req with empty fields set from profile.
+func (p BiasProfile) apply(req *ForwardGeocodeRequest) *ForwardGeocodeRequest {
+	biased := *req
+	if biased.Country == "" {
+		biased.Country = p.Country
+	}
+	if biased.Proximity == nil {
+		biased.Proximity = p.Proximity
+	}
+	if len(biased.Types) == 0 {
+		biased.Types = p.Types
+	}
+	if biased.Language == "" {
+		biased.Language = p.Language
+	}
+	return &biased
+}
+
+// filter drops features outside profile countries in place if StrictCountry is set
+// and returns number of dropped features.
+func (p BiasProfile) filter(features []Feature) ([]Feature, int) {
+	if !p.StrictCountry || p.Country == "" {
+		return features, 0
+	}
+
+	countries := strings.Split(strings.ToLower(p.Country), ",")
+	kept := features[:0]
+	for _, f := range features {
+		c, ok := f.Country()
+		if !ok {
+			continue
+		}
+		for _, country := range countries {
+			if strings.EqualFold(c.ShortCode, strings.TrimSpace(country)) {
+				kept = append(kept, f)
+				break
+			}
+		}
+	}
+
+	return kept, len(features) - len(kept)
+}
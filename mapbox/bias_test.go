@@ -0,0 +1,65 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestBiasProfiles(t *testing.T) {
+	var uri string
+	g := NewFastHttpGeocoder(AccessToken("token"), OmitDefaultParams(true),
+		BiasProfiles(map[string]BiasProfile{
+			"de": {Country: "de,at", Language: "de", Types: []string{"address"}, StrictCountry: true},
+		}),
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["berlin"],"features":[
+{"id":"place.1","text":"Berlin","context":[{"id":"country.1","short_code":"de","text":"Germany"}]},
+{"id":"place.2","text":"Berlin","context":[{"id":"country.2","short_code":"us","text":"United States"}]},
+{"id":"country.3","place_type":["country"],"text":"Austria","properties":{"short_code":"at"}}]}`)
+			return nil
+		})))
+
+	tests := []struct {
+		name         string
+		req          ForwardGeocodeRequest
+		wantURI      string
+		wantFeatures int
+	}{
+		{name: "no profile", req: ForwardGeocodeRequest{SearchText: "berlin"}, wantURI: "?access_token=token", wantFeatures: 3},
+		{
+			name:         "profile",
+			req:          ForwardGeocodeRequest{SearchText: "berlin", BiasProfile: "de"},
+			wantURI:      "?access_token=token&country=de,at&language=de&types=address",
+			wantFeatures: 2,
+		},
+		{
+			name:         "request fields take precedence",
+			req:          ForwardGeocodeRequest{SearchText: "berlin", BiasProfile: "de", Language: "en"},
+			wantURI:      "?access_token=token&country=de,at&language=en&types=address",
+			wantFeatures: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := g.ForwardGeocode(context.Background(), &tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasSuffix(uri, tt.wantURI) {
+				t.Errorf("uri = %s, want suffix %s", uri, tt.wantURI)
+			}
+			if len(resp.Features) != tt.wantFeatures || resp.Filtered != 3-tt.wantFeatures {
+				t.Errorf("unexpected features %+v filtered %d", resp.Features, resp.Filtered)
+			}
+		})
+	}
+
+	if _, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "berlin", BiasProfile: "fr"}); err == nil {
+		t.Error("unknown profile error expected")
+	}
+}
@@ -0,0 +1,124 @@
+package mapbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BudgetPeriod is a calendar period in UTC request budget is reset after.
+type BudgetPeriod string
+
+const (
+	BudgetDaily   BudgetPeriod = "day"
+	BudgetMonthly BudgetPeriod = "month"
+)
+
+// BudgetLimits caps number of requests to an endpoint, zero means no limit.
+type BudgetLimits struct {
+	Daily   int
+	Monthly int
+}
+
+// ErrBudgetExceeded is returned instead of calling mapbox when endpoint request budget is spent.
+type ErrBudgetExceeded struct {
+	Endpoint Endpoint
+	Period   BudgetPeriod
+	Limit    int
+}
+
+func (e *ErrBudgetExceeded) Error() string {
+	return fmt.Sprintf("mapbox %s request budget of %d per %s exceeded", e.Endpoint, e.Limit, e.Period)
+}
+
+// RequestBudget limits requests to endpoint per UTC day and month, so a bug or abuse can't run up mapbox bill.
+// Every request is counted including retries of rate limited calls.
+// Budgets are tracked per service in memory, they are not shared between processes.
+func RequestBudget(e Endpoint, l BudgetLimits) Option {
+	return func(c config) config {
+		budgets := make(map[Endpoint]BudgetLimits, len(c.budgets)+1)
+		for k, v := range c.budgets {
+			budgets[k] = v
+		}
+		budgets[e] = l
+		c.budgets = budgets
+		return c
+	}
+}
+
+// OnBudgetExceeded sets a hook called when request budget is spent, e.g. to alert.
+// The request is sent anyway if hook returns nil, otherwise the hook error is returned.
+// default to returning *ErrBudgetExceeded.
+func OnBudgetExceeded(hook func(ctx context.Context, err *ErrBudgetExceeded) error) Option {
+	return func(c config) config {
+		c.onBudgetExceeded = hook
+		return c
+	}
+}
+
+// budgetCounter counts endpoint requests of the current day and month.
+type budgetCounter struct {
+	day, month           time.Time
+	dayCalls, monthCalls int
+}
+
+// budgetTracker is shared by config copies.
+type budgetTracker struct {
+	mu       sync.Mutex
+	counters map[Endpoint]*budgetCounter
+}
+
+func newBudgetTracker() *budgetTracker {
+	return &budgetTracker{counters: make(map[Endpoint]*budgetCounter)}
+}
+
+// spend counts a request if it fits limits, otherwise it returns exceeded error without counting.
+func (t *budgetTracker) spend(e Endpoint, l BudgetLimits, now time.Time) *ErrBudgetExceeded {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cnt, ok := t.counters[e]
+	if !ok {
+		cnt = &budgetCounter{}
+		t.counters[e] = cnt
+	}
+	if !cnt.day.Equal(day) {
+		cnt.day, cnt.dayCalls = day, 0
+	}
+	if !cnt.month.Equal(month) {
+		cnt.month, cnt.monthCalls = month, 0
+	}
+
+	if l.Daily > 0 && cnt.dayCalls >= l.Daily {
+		return &ErrBudgetExceeded{Endpoint: e, Period: BudgetDaily, Limit: l.Daily}
+	}
+	if l.Monthly > 0 && cnt.monthCalls >= l.Monthly {
+		return &ErrBudgetExceeded{Endpoint: e, Period: BudgetMonthly, Limit: l.Monthly}
+	}
+
+	cnt.dayCalls++
+	cnt.monthCalls++
+	return nil
+}
+
+// spendBudget checks op request budget if set.
+func (c *config) spendBudget(ctx context.Context, op string) error {
+	l, ok := c.budgets[Endpoint(op)]
+	if !ok || c.budget == nil {
+		return nil
+	}
+
+	exceeded := c.budget.spend(Endpoint(op), l, c.clock.Now())
+	if exceeded == nil {
+		return nil
+	}
+	if c.onBudgetExceeded != nil {
+		return c.onBudgetExceeded(ctx, exceeded)
+	}
+	return exceeded
+}
@@ -0,0 +1,81 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestRequestBudget(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 1, 1, 23, 0, 0, 0, time.UTC)}
+	calls := 0
+	g := NewFastHttpGeocoder(AccessToken("token"), WithClock(clock),
+		RequestBudget(EndpointReverseGeocode, BudgetLimits{Daily: 2, Monthly: 3}),
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			calls++
+			resp.SetBody(testRespBody)
+			return nil
+		})))
+
+	reverse := func() error {
+		_, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{GeoPoint: GeoPoint{Lon: 1, Lat: 1}})
+		return err
+	}
+
+	tests := []struct {
+		name    string
+		advance time.Duration
+		want    *ErrBudgetExceeded
+	}{
+		{name: "first"},
+		{name: "second"},
+		{name: "daily exceeded", want: &ErrBudgetExceeded{Endpoint: EndpointReverseGeocode, Period: BudgetDaily, Limit: 2}},
+		{name: "next day", advance: time.Hour},
+		{name: "monthly exceeded", want: &ErrBudgetExceeded{Endpoint: EndpointReverseGeocode, Period: BudgetMonthly, Limit: 3}},
+		{name: "next month", advance: 30 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		clock.now = clock.now.Add(tt.advance)
+		err := reverse()
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", tt.name, err)
+			}
+			continue
+		}
+		got, ok := err.(*ErrBudgetExceeded)
+		if !ok || *got != *tt.want {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	if calls != 4 {
+		t.Errorf("calls = %d, want 4", calls)
+	}
+}
+
+func TestOnBudgetExceeded(t *testing.T) {
+	var exceeded []*ErrBudgetExceeded
+	g := NewFastHttpGeocoder(AccessToken("token"),
+		RequestBudget(EndpointReverseGeocode, BudgetLimits{Daily: 1}),
+		OnBudgetExceeded(func(ctx context.Context, err *ErrBudgetExceeded) error {
+			exceeded = append(exceeded, err)
+			return nil
+		}),
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.SetBody(testRespBody)
+			return nil
+		})))
+
+	for i := 0; i < 2; i++ {
+		if _, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{GeoPoint: GeoPoint{Lon: 1, Lat: 1}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(exceeded) != 1 || exceeded[0].Period != BudgetDaily {
+		t.Errorf("unexpected exceeded %+v", exceeded)
+	}
+}
@@ -0,0 +1,68 @@
+package mapbox
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	reqHeaderCacheControl   = "Cache-Control"
+	reqHeaderIdempotencyKey = "Idempotency-Key"
+	noCache                 = "no-cache"
+)
+
+// CallOption tunes a single call, e.g. g.ReverseGeocode(ctx, req, mapbox.WithTimeout(200*time.Millisecond)).
+// Calls made by a call, e.g. polls of WaitForSolution, inherit its options.
+type CallOption func(o callOptions) callOptions
+
+type callOptions struct {
+	noCache        bool
+	timeout        time.Duration
+	idempotencyKey string
+}
+
+// WithNoCache sends Cache-Control: no-cache request header, a hint for mapbox and proxies in between to bypass
+// their caches, they are free to ignore it. The SDK has no response cache of its own except SuggestCache,
+// which is skipped for the call, fresh suggestions still replace the cached ones.
+func WithNoCache() CallOption {
+	return func(o callOptions) callOptions {
+		o.noCache = true
+		return o
+	}
+}
+
+// WithTimeout bounds every request of the call including failover attempts, context.DeadlineExceeded is returned
+// on timeout. FastHttpClient has no cancellation, so a timed out request is left to complete in background.
+func WithTimeout(d time.Duration) CallOption {
+	return func(o callOptions) callOptions {
+		o.timeout = d
+		return o
+	}
+}
+
+// WithIdempotencyKey sets Idempotency-Key request header of SubmitRoutingProblem instead of a key derived from
+// the problem, e.g. to submit the same problem again as a new job.
+func WithIdempotencyKey(key string) CallOption {
+	return func(o callOptions) callOptions {
+		o.idempotencyKey = key
+		return o
+	}
+}
+
+// withCallOptions returns ctx carrying opts applied on top of ones already attached to ctx.
+func withCallOptions(ctx context.Context, opts []CallOption) context.Context {
+	if len(opts) == 0 {
+		return ctx
+	}
+
+	o := contextCallOptions(ctx)
+	for _, opt := range opts {
+		o = opt(o)
+	}
+	return context.WithValue(ctx, ctxKeyCallOptions, o)
+}
+
+func contextCallOptions(ctx context.Context) callOptions {
+	o, _ := ctx.Value(ctxKeyCallOptions).(callOptions)
+	return o
+}
@@ -0,0 +1,40 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestCallOptions(t *testing.T) {
+	var cacheControl string
+	delay := time.Duration(0)
+	g := NewFastHttpGeocoder(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			cacheControl = string(req.Header.Peek("Cache-Control"))
+			time.Sleep(delay)
+			resp.SetBodyString(`{"type":"FeatureCollection","features":[]}`)
+			return nil
+		})))
+	req := &ReverseGeocodeRequest{GeoPoint: GeoPoint{Lon: 1, Lat: 2}}
+
+	if _, err := g.ReverseGeocode(context.Background(), req); err != nil || cacheControl != "" {
+		t.Errorf("ReverseGeocode() Cache-Control = %q, err = %v, want no header", cacheControl, err)
+	}
+	if _, err := g.ReverseGeocode(context.Background(), req, WithNoCache(), WithTimeout(time.Second)); err != nil || cacheControl != "no-cache" {
+		t.Errorf("ReverseGeocode(WithNoCache()) Cache-Control = %q, err = %v, want no-cache", cacheControl, err)
+	}
+
+	delay = 100 * time.Millisecond
+	if _, err := g.ReverseGeocode(context.Background(), req, WithTimeout(time.Millisecond)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ReverseGeocode(WithTimeout()) err = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	ctx := withCallOptions(context.Background(), []CallOption{WithNoCache()})
+	if o := contextCallOptions(withCallOptions(ctx, []CallOption{WithTimeout(time.Second)})); !o.noCache || o.timeout != time.Second {
+		t.Errorf("inherited options = %+v, want both applied", o)
+	}
+}
@@ -0,0 +1,152 @@
+package mapbox
+
+import (
+	"sort"
+)
+
+// Canonical Search Box POI category ids.
+// The list is a snapshot of the most used categories, see https://docs.mapbox.com/api/search/search-box/#list-categories for the full one.
+const (
+	CategoryServices          = "services"
+	CategoryShopping          = "shopping"
+	CategoryFoodAndDrink      = "food_and_drink"
+	CategoryFood              = "food"
+	CategoryRestaurant        = "restaurant"
+	CategoryFastFood          = "fast_food"
+	CategoryCafe              = "cafe"
+	CategoryCoffee            = "coffee"
+	CategoryBakery            = "bakery"
+	CategoryBar               = "bar"
+	CategoryNightlife         = "nightlife"
+	CategoryGrocery           = "grocery"
+	CategorySupermarket       = "supermarket"
+	CategoryConvenienceStore  = "convenience_store"
+	CategoryClothingStore     = "clothing_store"
+	CategoryPharmacy          = "pharmacy"
+	CategoryHospital          = "hospital"
+	CategoryHotel             = "hotel"
+	CategoryLodging           = "lodging"
+	CategoryGasStation        = "gas_station"
+	CategoryChargingStation   = "charging_station"
+	CategoryParking           = "parking"
+	CategoryParkingLot        = "parking_lot"
+	CategoryATM               = "atm"
+	CategoryBank              = "bank"
+	CategoryPostOffice        = "post_office"
+	CategoryPark              = "park"
+	CategoryMuseum            = "museum"
+	CategoryTouristAttraction = "tourist_attraction"
+	CategorySchool            = "school"
+	CategoryUniversity        = "university"
+	CategoryGym               = "gym"
+	CategoryFitnessCenter     = "fitness_center"
+	CategoryBusStation        = "bus_station"
+	CategoryTrainStation      = "train_station"
+	CategoryAirport           = "airport"
+)
+
+var knownCategories = map[string]struct{}{
+	CategoryServices:          {},
+	CategoryShopping:          {},
+	CategoryFoodAndDrink:      {},
+	CategoryFood:              {},
+	CategoryRestaurant:        {},
+	CategoryFastFood:          {},
+	CategoryCafe:              {},
+	CategoryCoffee:            {},
+	CategoryBakery:            {},
+	CategoryBar:               {},
+	CategoryNightlife:         {},
+	CategoryGrocery:           {},
+	CategorySupermarket:       {},
+	CategoryConvenienceStore:  {},
+	CategoryClothingStore:     {},
+	CategoryPharmacy:          {},
+	CategoryHospital:          {},
+	CategoryHotel:             {},
+	CategoryLodging:           {},
+	CategoryGasStation:        {},
+	CategoryChargingStation:   {},
+	CategoryParking:           {},
+	CategoryParkingLot:        {},
+	CategoryATM:               {},
+	CategoryBank:              {},
+	CategoryPostOffice:        {},
+	CategoryPark:              {},
+	CategoryMuseum:            {},
+	CategoryTouristAttraction: {},
+	CategorySchool:            {},
+	CategoryUniversity:        {},
+	CategoryGym:               {},
+	CategoryFitnessCenter:     {},
+	CategoryBusStation:        {},
+	CategoryTrainStation:      {},
+	CategoryAirport:           {},
+}
+
+// Categories returns sorted list of known canonical category ids.
+func Categories() []string {
+	ids := make([]string, 0, len(knownCategories))
+	for id := range knownCategories {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// IsKnownCategory reports whether id is a known canonical category id.
+func IsKnownCategory(id string) bool {
+	_, ok := knownCategories[id]
+	return ok
+}
+
+// ValidateCategory returns an error with the closest known category if id is unknown.
+// Mapbox silently returns empty results for unknown categories, so typos are better caught before the call.
+func ValidateCategory(id string) error {
+	if IsKnownCategory(id) {
+		return nil
+	}
+
+	closest, distance := "", -1
+	for _, known := range Categories() {
+		d := levenshtein(id, known)
+		if distance < 0 || d < distance {
+			closest, distance = known, d
+		}
+	}
+
+	if distance >= 0 && distance <= len(closest)/3 {
+		return validationErrorf("Category", ConstraintEnum, "unknown category %q, did you mean %q", id, closest)
+	}
+	return validationErrorf("Category", ConstraintEnum, "unknown category %q", id)
+}
+
+// levenshtein returns edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(minInt(prev[j]+1, cur[j-1]+1), prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,32 @@
+package mapbox
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateCategory(t *testing.T) {
+	tests := []struct {
+		id      string
+		wantErr string
+	}{
+		{id: CategoryCoffee},
+		{id: "cofee", wantErr: `did you mean "coffee"`},
+		{id: "charging_stations", wantErr: `did you mean "charging_station"`},
+		{id: "spaceport", wantErr: `unknown category "spaceport"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			err := ValidateCategory(tt.id)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateCategory() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,7 +1,40 @@
 package mapbox
 
+import "context"
+
 // Client covers all Mabpox API
+// FastHttp implementations are configured with options once at construction
+// and never change afterwards, so they are safe for concurrent use.
 type Client interface {
+	// Datasets covers datasets mapbox API
+	Datasets
+	// Directions covers directions mapbox API
+	Directions
 	// Geocoder covers forward and reverse geocoding mapbox API
 	Geocoder
-}
\ No newline at end of file
+	// GeocoderV6 covers geocoding v6 mapbox API
+	GeocoderV6
+	// Optimization covers optimization mapbox API
+	Optimization
+	// SearchBox covers search box suggest and retrieve mapbox API
+	SearchBox
+	// StaticImages covers static images mapbox API
+	StaticImages
+	// Styles covers styles mapbox API
+	Styles
+	// Tilequery covers tilequery mapbox API
+	Tilequery
+	// Tilesets covers tilesets mapbox API
+	Tilesets
+	// Uploads covers uploads mapbox API
+	Uploads
+	// VectorTiles covers vector tiles mapbox API
+	VectorTiles
+
+	// Warmup opens connections to configured hosts ahead of the first call
+	Warmup(ctx context.Context) error
+	// RecentCalls returns calls kept with RecordCalls option
+	RecentCalls() []RecordedCall
+	// Close drains in-flight calls for a clean shutdown
+	Close(ctx context.Context) error
+}
@@ -0,0 +1,40 @@
+package mapbox
+
+import (
+	"context"
+	"time"
+)
+
+// Clock abstracts time for retries, rate limit waits and polling,
+// so they could be tested deterministically and simulated quickly.
+type Clock interface {
+	Now() time.Time
+	// Sleep blocks for d or until ctx is done.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// WithClock sets clock, default to the system one.
+func WithClock(clock Clock) Option {
+	return func(c config) config {
+		c.clock = clock
+		return c
+	}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
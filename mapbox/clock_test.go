@@ -0,0 +1,116 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// fakeClock moves time forward on Sleep instead of blocking.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func Test_config_retryRateLimited(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+
+	attempts := 0
+	c := NewFastHttpDatasets(Username("user"), WithClock(clock), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			attempts++
+			if attempts == 2 {
+				resp.Header.Set(respHeaderRateLimitReset, strconv.FormatInt(clock.now.Unix()+30, 10))
+			}
+			if attempts < 4 {
+				resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+			}
+			return nil
+		})))
+
+	report := c.UpsertFeatures(context.Background(), "dataset", []DatasetFeature{{ID: "a"}},
+		UpsertOptions{Concurrency: 1, MaxRetries: 3, Backoff: time.Second})
+	if len(report.Succeeded) != 1 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+
+	want := []time.Duration{time.Second, 30 * time.Second, 4 * time.Second}
+	if !reflect.DeepEqual(clock.sleeps, want) {
+		t.Errorf("sleeps = %v, want %v", clock.sleeps, want)
+	}
+}
+
+func TestMeta(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+
+	attempts := 0
+	c := NewFastHttpStyles(WithClock(clock), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			attempts++
+			clock.now = clock.now.Add(time.Second)
+			if attempts < 3 {
+				resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+			}
+			resp.SetBodyString(`[]`)
+			return nil
+		})))
+
+	ctx := context.Background()
+	resp, err := c.retryRateLimited(ctx, 3, time.Second, func() (*rawResponse, error) {
+		return c.do(ctx, "list styles", getMethod, c.apiURL("/styles/v1/user"), nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 3 requests taking a second each and 1s, 2s backoff sleeps
+	want := Meta{Endpoint: "list styles", Duration: 6 * time.Second, Attempts: 3}
+	if resp.meta != want {
+		t.Errorf("meta = %+v, want %+v", resp.meta, want)
+	}
+
+	// the first rate limited call fails over from the primary host, the retry goes to the proxy only
+	attempts = 0
+	failover := NewFastHttpStyles(WithClock(clock), RootAPI("https://primary"),
+		Failover(FailoverOptions{RootAPIs: []string{"https://proxy"}}),
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			if strings.HasPrefix(string(req.RequestURI()), "https://primary") {
+				return errors.New("connection refused")
+			}
+			attempts++
+			if attempts < 2 {
+				resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+			}
+			return nil
+		})))
+	resp, err = failover.retryRateLimited(ctx, 3, time.Second, func() (*rawResponse, error) {
+		return failover.do(ctx, "list styles", getMethod, failover.apiURL("/styles/v1/user"), nil)
+	})
+	if err != nil || resp.meta.Attempts != 3 {
+		t.Errorf("failover attempts = %+v, %v, want 3", resp, err)
+	}
+
+	styles, err := c.ListStyles(ctx, &ListStylesRequest{Username: "user"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Meta{Endpoint: "list styles", Duration: time.Second, Attempts: 1}); styles.Meta != want {
+		t.Errorf("list styles meta = %+v, want %+v", styles.Meta, want)
+	}
+}
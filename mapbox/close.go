@@ -0,0 +1,80 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned by calls made after Close.
+var ErrClosed = errors.New("mapbox client is closed")
+
+// lifecycle tracks in-flight calls so Close could drain them, it is shared by config copies.
+type lifecycle struct {
+	mu       sync.Mutex
+	inFlight sync.WaitGroup
+	closed   chan struct{}
+}
+
+func newLifecycle() *lifecycle {
+	return &lifecycle{closed: make(chan struct{})}
+}
+
+// acquire registers in-flight call, it returns false if client is closed.
+func (l *lifecycle) acquire() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	select {
+	case <-l.closed:
+		return false
+	default:
+	}
+
+	l.inFlight.Add(1)
+	return true
+}
+
+func (l *lifecycle) release() {
+	l.inFlight.Done()
+}
+
+// bind returns ctx canceled on Close, so pollers stop waiting.
+func (l *lifecycle) bind(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-l.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// Close rejects new calls with ErrClosed, stops pollers like WaitForUpload
+// and waits for in-flight calls until ctx is done. It could be called more than once.
+func (c *config) Close(ctx context.Context) error {
+	l := c.life
+
+	l.mu.Lock()
+	select {
+	case <-l.closed:
+	default:
+		close(l.closed)
+	}
+	l.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		l.inFlight.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return errorf("failed to drain in-flight calls: %w", ctx.Err())
+	}
+}
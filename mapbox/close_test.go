@@ -0,0 +1,72 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestClose(t *testing.T) {
+	started, unblock := make(chan struct{}), make(chan struct{})
+	c := NewFastHttpStyles(HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		close(started)
+		<-unblock
+		resp.SetBodyString(`[]`)
+		return nil
+	})))
+
+	inFlight := make(chan error)
+	go func() {
+		_, err := c.ListStyles(context.Background(), &ListStylesRequest{Username: "user"})
+		inFlight <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := c.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("deadline error expected, got %v", err)
+	}
+
+	if _, err := c.ListStyles(context.Background(), &ListStylesRequest{Username: "user"}); err != ErrClosed {
+		t.Errorf("ErrClosed expected, got %v", err)
+	}
+
+	close(unblock)
+	if err := <-inFlight; err != nil {
+		t.Errorf("in-flight call failed %v", err)
+	}
+	if err := c.Close(context.Background()); err != nil {
+		t.Errorf("drained close failed %v", err)
+	}
+}
+
+func TestClose_WaitForUpload(t *testing.T) {
+	c := NewFastHttpUploads(Username("user"), PollInterval(time.Hour), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.SetBodyString(`{"id":"upload","complete":false}`)
+			return nil
+		})))
+
+	done := make(chan error)
+	go func() {
+		_, err := c.WaitForUpload(context.Background(), "upload", nil)
+		done <- err
+	}()
+
+	if err := c.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("error expected")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WaitForUpload is not stopped by Close")
+	}
+}
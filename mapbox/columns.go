@@ -0,0 +1,183 @@
+package mapbox
+
+import (
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// Column is a feature field decoded by ColumnsDecoder, columns could be combined with |.
+type Column int
+
+const (
+	ColumnID Column = 1 << iota
+	ColumnText
+	ColumnPlaceName
+	ColumnCenter
+	ColumnRelevance
+	// ColumnPlaceType is the first feature place type.
+	ColumnPlaceType
+)
+
+// FeatureColumns holds requested feature fields in parallel slices, fields of i-th feature share i index.
+// Slices of columns which are not requested are nil.
+type FeatureColumns struct {
+	// Rows maps features to batch rows, it is set by Append only.
+	Rows []int
+
+	IDs        []string
+	Texts      []string
+	PlaceNames []string
+	Centers    []GeoPoint
+	Relevances []float64
+	PlaceTypes []string
+
+	n int
+}
+
+// Len returns number of features.
+func (fc *FeatureColumns) Len() int {
+	return fc.n
+}
+
+// Append appends src features and maps them to row, e.g. a bulk job input line.
+func (fc *FeatureColumns) Append(row int, src *FeatureColumns) {
+	for i := 0; i < src.n; i++ {
+		fc.Rows = append(fc.Rows, row)
+	}
+
+	fc.IDs = appendStrings(fc.IDs, src.IDs)
+	fc.Texts = appendStrings(fc.Texts, src.Texts)
+	fc.PlaceNames = appendStrings(fc.PlaceNames, src.PlaceNames)
+	if src.Centers != nil {
+		fc.Centers = append(fc.Centers, src.Centers...)
+	}
+	if src.Relevances != nil {
+		fc.Relevances = append(fc.Relevances, src.Relevances...)
+	}
+	fc.PlaceTypes = appendStrings(fc.PlaceTypes, src.PlaceTypes)
+
+	fc.n += src.n
+}
+
+// appendStrings keeps dst nil if column is not requested.
+func appendStrings(dst, src []string) []string {
+	if src == nil {
+		return dst
+	}
+	return append(dst, src...)
+}
+
+// ColumnsDecoder returns CustomDecoder decoder extracting only cols of geocode response features
+// into *FeatureColumns, it skips Feature materialization to cut memory of bulk enrichment jobs.
+func ColumnsDecoder(cols Column) Decoder {
+	return func(body []byte) (interface{}, error) {
+		fc := &FeatureColumns{}
+		if err := fc.decode(body, cols); err != nil {
+			return nil, err
+		}
+		return fc, nil
+	}
+}
+
+func (fc *FeatureColumns) decode(body []byte, cols Column) error {
+	in := jlexer.Lexer{Data: body}
+
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if key == "features" && !in.IsNull() {
+			in.Delim('[')
+			for !in.IsDelim(']') {
+				fc.decodeFeature(&in, cols)
+				in.WantComma()
+			}
+			in.Delim(']')
+		} else {
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	in.Consumed()
+
+	if err := in.Error(); err != nil {
+		return errorf("failed to decode feature columns: %w", err)
+	}
+	return nil
+}
+
+// decodeFeature appends requested fields of a single feature, missing fields are zero filled
+// so slices stay parallel.
+func (fc *FeatureColumns) decodeFeature(in *jlexer.Lexer, cols Column) {
+	var (
+		id, text, placeName, placeType string
+		center                         GeoPoint
+		relevance                      float64
+	)
+
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		switch {
+		case in.IsNull():
+			in.Skip()
+		case key == "id" && cols&ColumnID != 0:
+			id = in.String()
+		case key == "text" && cols&ColumnText != 0:
+			text = in.String()
+		case key == "place_name" && cols&ColumnPlaceName != 0:
+			placeName = in.String()
+		case key == "relevance" && cols&ColumnRelevance != 0:
+			relevance = in.Float64()
+		case key == "center" && cols&ColumnCenter != 0:
+			var lonLat [2]float64
+			in.Delim('[')
+			for i := 0; !in.IsDelim(']'); i++ {
+				if i < len(lonLat) {
+					lonLat[i] = in.Float64()
+				} else {
+					in.SkipRecursive()
+				}
+				in.WantComma()
+			}
+			in.Delim(']')
+			center = GeoPoint{Lon: lonLat[0], Lat: lonLat[1]}
+		case key == "place_type" && cols&ColumnPlaceType != 0:
+			in.Delim('[')
+			for i := 0; !in.IsDelim(']'); i++ {
+				if i == 0 {
+					placeType = in.String()
+				} else {
+					in.SkipRecursive()
+				}
+				in.WantComma()
+			}
+			in.Delim(']')
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+
+	if cols&ColumnID != 0 {
+		fc.IDs = append(fc.IDs, id)
+	}
+	if cols&ColumnText != 0 {
+		fc.Texts = append(fc.Texts, text)
+	}
+	if cols&ColumnPlaceName != 0 {
+		fc.PlaceNames = append(fc.PlaceNames, placeName)
+	}
+	if cols&ColumnCenter != 0 {
+		fc.Centers = append(fc.Centers, center)
+	}
+	if cols&ColumnRelevance != 0 {
+		fc.Relevances = append(fc.Relevances, relevance)
+	}
+	if cols&ColumnPlaceType != 0 {
+		fc.PlaceTypes = append(fc.PlaceTypes, placeType)
+	}
+	fc.n++
+}
@@ -0,0 +1,46 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestColumnsDecoder(t *testing.T) {
+	g := NewFastHttpGeocoder(
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.SetBody(testRespBody)
+			return nil
+		})),
+		CustomDecoder(EndpointReverseGeocode, ColumnsDecoder(ColumnPlaceName|ColumnCenter|ColumnPlaceType)),
+	)
+
+	batch := &FeatureColumns{}
+	for row := 0; row < 2; row++ {
+		resp, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch.Append(row, resp.Decoded.(*FeatureColumns))
+	}
+
+	if batch.Len() != 12 || len(batch.Rows) != 12 || batch.Rows[5] != 0 || batch.Rows[6] != 1 {
+		t.Fatalf("unexpected rows %v", batch.Rows)
+	}
+	if batch.IDs != nil || batch.Texts != nil || batch.Relevances != nil {
+		t.Errorf("not requested columns are expected to be nil")
+	}
+	if len(batch.PlaceNames) != 12 || len(batch.Centers) != 12 || len(batch.PlaceTypes) != 12 {
+		t.Fatalf("columns are expected to be parallel %+v", batch)
+	}
+
+	if batch.PlaceNames[6] != "2 Lincoln Memorial Circle SW, Washington, District of Columbia 20024, United States" ||
+		batch.Centers[6] != (GeoPoint{Lon: -77.0501629, Lat: 38.8892227}) || batch.PlaceTypes[6] != "address" {
+		t.Errorf("unexpected second row first feature %s %v %s", batch.PlaceNames[6], batch.Centers[6], batch.PlaceTypes[6])
+	}
+
+	if _, err := ColumnsDecoder(ColumnID)([]byte(`{"features":[`)); err == nil {
+		t.Error("decode error expected")
+	}
+}
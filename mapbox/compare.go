@@ -0,0 +1,59 @@
+package mapbox
+
+import "math"
+
+// defaultOverlapTolerance is a max distance in meters between overlapping routes.
+const defaultOverlapTolerance = 25
+
+// RouteComparison describes how route b differs from route a.
+type RouteComparison struct {
+	// DurationDelta is b duration minus a one in seconds.
+	DurationDelta float64
+	// DistanceDelta is b distance minus a one in meters.
+	DistanceDelta float64
+	// OverlapPercent is a share of a geometry length within tolerance of b geometry, from 0 to 100.
+	// It is 0 if any of routes has no geometry, e.g. requested with OverviewFalse.
+	OverlapPercent float64
+}
+
+// CompareRoutes compares routes, e.g. of different profiles or a planned and a rerouted one.
+// tolerance is a max distance in meters between overlapping geometries, default 25.
+func CompareRoutes(a, b Route, tolerance float64) RouteComparison {
+	if tolerance <= 0 {
+		tolerance = defaultOverlapTolerance
+	}
+
+	return RouteComparison{
+		DurationDelta:  b.Duration - a.Duration,
+		DistanceDelta:  b.Distance - a.Distance,
+		OverlapPercent: 100 * overlap(a.Geometry.LineString(), b.Geometry.LineString(), tolerance),
+	}
+}
+
+// overlap returns a share of a length within tolerance of b.
+// a segments are sampled every tolerance meters.
+func overlap(a, b LineString, tolerance float64) float64 {
+	total := a.Length()
+	if total == 0 || len(b) == 0 {
+		return 0
+	}
+
+	var covered float64
+	for i := 1; i < len(a); i++ {
+		seg := Distance(a[i-1], a[i])
+		samples := int(math.Ceil(seg / tolerance))
+		if samples == 0 {
+			continue
+		}
+
+		step := seg / float64(samples)
+		for j := 0; j < samples; j++ {
+			mid := interpolate(a[i-1], a[i], (float64(j)+0.5)*step, seg)
+			if b.DistanceTo(mid) <= tolerance {
+				covered += step
+			}
+		}
+	}
+
+	return covered / total
+}
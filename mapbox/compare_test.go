@@ -0,0 +1,43 @@
+package mapbox
+
+import (
+	"math"
+	"testing"
+)
+
+func lineRoute(duration, distance float64, coordinates ...[]float64) Route {
+	return Route{
+		Duration: duration,
+		Distance: distance,
+		Geometry: RouteGeometry{Type: "LineString", Coordinates: coordinates},
+	}
+}
+
+func TestCompareRoutes(t *testing.T) {
+	planned := lineRoute(600, 5000, []float64{0, 0}, []float64{0.01, 0}, []float64{0.02, 0})
+
+	tests := []struct {
+		name        string
+		b           Route
+		wantOverlap float64
+	}{
+		{name: "same", b: planned, wantOverlap: 100},
+		{name: "half rerouted", b: lineRoute(700, 5600, []float64{0, 0}, []float64{0.01, 0}, []float64{0.01, 0.01}), wantOverlap: 50},
+		{name: "disjoint", b: lineRoute(900, 7000, []float64{0, 0.01}, []float64{0.02, 0.01}), wantOverlap: 0},
+		{name: "polyline", b: Route{Duration: 600, Distance: 5000, Geometry: RouteGeometry{Polyline: "???o}@?o}@"}}, wantOverlap: 100},
+		{name: "polyline6", b: Route{Duration: 600, Distance: 5000, Geometry: RouteGeometry{Polyline: "???_pR?_pR", Precision: 6}}, wantOverlap: 100},
+		{name: "no geometry", b: Route{Duration: 600, Distance: 5000}, wantOverlap: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CompareRoutes(planned, tt.b, 0)
+			if got.DurationDelta != tt.b.Duration-600 || got.DistanceDelta != tt.b.Distance-5000 {
+				t.Errorf("unexpected deltas %+v", got)
+			}
+			if math.Abs(got.OverlapPercent-tt.wantOverlap) > 2 {
+				t.Errorf("OverlapPercent = %v, want %v", got.OverlapPercent, tt.wantOverlap)
+			}
+		})
+	}
+}
@@ -2,28 +2,140 @@ package mapbox
 
 import (
 	"context"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/valyala/fasthttp"
 )
 
 const (
 	defaultAPI = "https://api.mapbox.com"
+
+	defaultPollInterval = 5 * time.Second
+)
+
+// PlacesEndpoint is a geocoding v5 endpoint.
+type PlacesEndpoint string
+
+const (
+	// PlacesTemporary results must not be stored.
+	PlacesTemporary PlacesEndpoint = "mapbox.places"
+	// PlacesPermanent results could be stored, it requires permanent geocoding access.
+	PlacesPermanent PlacesEndpoint = "mapbox.places-permanent"
 )
 
 // Option allows gradually modify config
 type Option func(c config) config
 
 type config struct {
-	accessToken   string
-	rootAPI       string
-	client        FastHttpClient
-	logger        Logger
+	accessToken string
+	// publicAccessToken is a restricted token safe to hand to browsers.
+	publicAccessToken string
+	rootAPI           string
+	client            FastHttpClient
+	logger            Logger
 	// requestLogger will be called instead of testLogger if set.
 	requestLogger func(ctx context.Context) Logger
+	// logMode limits what is written to debug logs.
+	logMode LogMode
+	// decodeMode defines whether responses are checked against expected schema.
+	decodeMode DecodeMode
+	// softFail skips features failed to decode.
+	softFail bool
+	// decoders replace SDK response decoding for endpoints.
+	decoders map[Endpoint]Decoder
+	// accessLog is called once per API call.
+	accessLog func(ctx context.Context, r AccessRecord)
+
+	// username owns account scoped resources like uploads, default to the access token owner.
+	username string
+	// timezoneResolver enriches reverse geocode results with timezone.
+	timezoneResolver TimezoneResolver
+	// timing enables Meta.Timing.
+	timing bool
+
+	// pollInterval is a delay between long running job status checks.
+	pollInterval time.Duration
+	// clock is used for retries and polling delays.
+	clock Clock
+
+	// contexts pools feature context slices if set.
+	contexts *contextPool
+
+	// life is created per service by build, see Close.
+	life *lifecycle
+
+	// budgets limit requests per endpoint, budget is created per service by build if set.
+	budgets          map[Endpoint]BudgetLimits
+	budget           *budgetTracker
+	onBudgetExceeded func(ctx context.Context, err *ErrBudgetExceeded) error
+
+	// recorder is created per service by build if recordCalls is set, see RecentCalls.
+	recordCalls int
+	recorder    *callRecorder
+
+	// suggests is created per service by build if suggestTTL and suggestSize are set, see SuggestCache.
+	suggestTTL  time.Duration
+	suggestSize int
+	suggests    *suggestCache
+
+	// failover is set with Failover option, hosts are built from it.
+	failover *FailoverOptions
+	hosts    *hostPool
+
+	// preprocessQuery rewrites forward geocode search text.
+	preprocessQuery func(text string) string
+
+	// thresholds filter geocode features.
+	thresholds Thresholds
+	// biasProfiles are selected by forward geocode requests.
+	biasProfiles map[string]BiasProfile
+	// typesPresets override types presets, keyed by preset or preset/country.
+	typesPresets map[string][]string
+	// geofence drops results outside regions.
+	geofence          []Region
+	onGeofenceDropped func(ctx context.Context, op string, dropped int)
+
+	// permanent requests storable geocoding results.
+	permanent bool
+	// strictStorage forbids retaining temporary results.
+	strictStorage bool
+	// permanentFallback retries forbidden permanent v5 calls with temporary endpoint.
+	permanentFallback bool
+
+	// omitDefaults skips query params equal to mapbox defaults.
+	omitDefaults bool
 
 	accessTokenGetValue []byte
-	geocodeEndpoint string
+	geocodeEndpoint     PlacesEndpoint
+
+	// err is an invalid options error returned by every call.
+	err error
+}
+
+// build creates config for a service constructor.
+// Services never change config after build, so they are safe for concurrent use.
+func build(opts []Option) config {
+	c := newConfig()
+	for _, o := range opts {
+		c = o(c)
+	}
+
+	c = c.withEnv()
+	c = c.prepare()
+	c.life = newLifecycle()
+	if len(c.budgets) > 0 {
+		c.budget = newBudgetTracker()
+	}
+	if c.recordCalls > 0 {
+		c.recorder = newCallRecorder(c.recordCalls)
+	}
+	if c.suggestTTL > 0 && c.suggestSize > 0 {
+		c.suggests = newSuggestCache(c.suggestTTL, c.suggestSize)
+	}
+
+	return c
 }
 
 // withEnv overwrites config values with env is not empty
@@ -33,21 +145,64 @@ func (c config) withEnv() config {
 		c.accessToken = at
 	}
 
+	pat := os.Getenv("MAPBOX_PUBLIC_ACCESS_TOKEN")
+	if pat != "" {
+		c.publicAccessToken = pat
+	}
+
 	return c
 }
 
 // prepare prebuilds some reused api parts like access token http get value
 func (c config) prepare() config {
 	c.accessTokenGetValue = []byte(questionMark + access_token + string(equalMark) + c.accessToken)
+	if c.username == "" {
+		c.username = usernameFromToken(c.accessToken)
+	}
+	if c.permanent && c.geocodeEndpoint == PlacesTemporary {
+		c.geocodeEndpoint = PlacesPermanent
+	}
+	if c.geocodeEndpoint != PlacesTemporary && c.geocodeEndpoint != PlacesPermanent {
+		c.err = errorf("unknown geocode endpoint %q", c.geocodeEndpoint)
+	}
+
+	if u, err := url.Parse(c.rootAPI); err != nil || u.Scheme == "" || u.Host == "" {
+		c.err = errorf("invalid root api %q", c.rootAPI)
+	}
+
+	if c.failover != nil {
+		hosts, err := newHostPool(c.rootAPI, c.failover)
+		if err != nil {
+			c.err = err
+		}
+		c.hosts = hosts
+	}
 
 	return c
 }
 
+// Validate returns invalid options error, e.g. unknown GeocodeEndpoint or invalid RootAPI,
+// so misconfiguration could be caught right after a service is constructed instead of on the first call.
+func (c *config) Validate() error {
+	return c.err
+}
+
+// apiURL joins root api and path parts.
+func (c *config) apiURL(parts ...string) []byte {
+	u := []byte(c.rootAPI)
+	for _, p := range parts {
+		u = append(u, p...)
+	}
+	return u
+}
+
 func newConfig() config {
 	return config{
 		rootAPI:         defaultAPI,
 		client:          &fasthttp.Client{},
-		geocodeEndpoint: "mapbox.places",
+		geocodeEndpoint: PlacesTemporary,
+		pollInterval:    defaultPollInterval,
+		clock:           systemClock{},
 	}
 }
 
@@ -67,6 +222,7 @@ func RequestLogger(extract func(ctx context.Context) Logger) Option {
 		return c
 	}
 }
+
 // AccessToken sets access_token get param.
 // Could be set with MAPBOX_ACCESS_TOKEN too.
 func AccessToken(at string) Option {
@@ -76,6 +232,33 @@ func AccessToken(at string) Option {
 	}
 }
 
+// PublicAccessToken sets restricted public token used for URLs handed to clients, e.g. static images.
+// Could be set with MAPBOX_PUBLIC_ACCESS_TOKEN too.
+func PublicAccessToken(at string) Option {
+	return func(c config) config {
+		c.publicAccessToken = at
+		return c
+	}
+}
+
+// Username sets owner of account scoped resources like uploads.
+// default to the access token owner.
+func Username(username string) Option {
+	return func(c config) config {
+		c.username = username
+		return c
+	}
+}
+
+// PollInterval sets delay between status checks of long running jobs like uploads.
+// default to 5s
+func PollInterval(d time.Duration) Option {
+	return func(c config) config {
+		c.pollInterval = d
+		return c
+	}
+}
+
 // RootAPI allows to change root api address.
 // default to https://api.mapbox.com
 func RootAPI(rootAPI string) Option {
@@ -93,9 +276,20 @@ func HttpClient(c FastHttpClient) Option {
 	}
 }
 
-// GeocodeEndpoint sets geocode endpoint.
-// could be set to mapbox.places-permanent, defualt to mapbox.places
-func GeocodeEndpoint(endpoint string) Option {
+// OmitDefaultParams skips query params equal to mapbox defaults, e.g. autocomplete=true,
+// so URLs are shorter and equal requests are more likely to hit mapbox cache.
+// default to false, all forward geocode params are sent explicitly.
+func OmitDefaultParams(omit bool) Option {
+	return func(c config) config {
+		c.omitDefaults = omit
+		return c
+	}
+}
+
+// GeocodeEndpoint sets geocode endpoint, it must be PlacesTemporary or PlacesPermanent,
+// any other value is reported by Validate and fails every call.
+// default to PlacesTemporary or PlacesPermanent if Permanent option is set
+func GeocodeEndpoint(endpoint PlacesEndpoint) Option {
 	return func(c config) config {
 		c.geocodeEndpoint = endpoint
 		return c
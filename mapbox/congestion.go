@@ -0,0 +1,55 @@
+package mapbox
+
+// CongestionSummary aggregates leg congestion annotation, distances are in meters.
+type CongestionSummary struct {
+	// Distance is a total distance of annotated segments.
+	Distance float64
+	// CongestedPercent is a share of Distance in heavy or severe congestion, from 0 to 100.
+	CongestedPercent float64
+	// LongestCongested is the longest stretch of consecutive heavy or severe segments.
+	LongestCongested float64
+}
+
+// CongestionSummary summarizes leg congestion, ok is false unless both AnnotationCongestion and AnnotationDistance
+// were requested.
+func (l RouteLeg) CongestionSummary() (s CongestionSummary, ok bool) {
+	a := l.Annotation
+	if a == nil || len(a.Congestion) == 0 || len(a.Congestion) != len(a.Distance) {
+		return CongestionSummary{}, false
+	}
+
+	var congested, stretch float64
+	for i, level := range a.Congestion {
+		d := a.Distance[i]
+		s.Distance += d
+
+		if level != CongestionHeavy && level != CongestionSevere {
+			stretch = 0
+			continue
+		}
+		congested += d
+		stretch += d
+		if stretch > s.LongestCongested {
+			s.LongestCongested = stretch
+		}
+	}
+
+	if s.Distance > 0 {
+		s.CongestedPercent = 100 * congested / s.Distance
+	}
+	return s, true
+}
+
+// CongestionSummaries summarizes congestion of every route leg, see RouteLeg.CongestionSummary.
+// It returns nil if any leg is missing annotations.
+func (r Route) CongestionSummaries() []CongestionSummary {
+	summaries := make([]CongestionSummary, 0, len(r.Legs))
+	for _, l := range r.Legs {
+		s, ok := l.CongestionSummary()
+		if !ok {
+			return nil
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries
+}
@@ -0,0 +1,48 @@
+package mapbox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRouteLeg_CongestionSummary(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation *LegAnnotation
+		want       CongestionSummary
+		wantOk     bool
+	}{
+		{
+			name: "mixed",
+			annotation: &LegAnnotation{
+				Congestion: []string{CongestionLow, CongestionHeavy, CongestionSevere, CongestionModerate, CongestionHeavy},
+				Distance:   []float64{100, 50, 150, 500, 200},
+			},
+			want:   CongestionSummary{Distance: 1000, CongestedPercent: 40, LongestCongested: 200},
+			wantOk: true,
+		},
+		{
+			name: "free flow",
+			annotation: &LegAnnotation{
+				Congestion: []string{CongestionLow, CongestionUnknown},
+				Distance:   []float64{10, 20},
+			},
+			want:   CongestionSummary{Distance: 30},
+			wantOk: true,
+		},
+		{name: "no annotation"},
+		{
+			name:       "no distance",
+			annotation: &LegAnnotation{Congestion: []string{CongestionHeavy}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RouteLeg{Annotation: tt.annotation}.CongestionSummary()
+			if ok != tt.wantOk || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CongestionSummary() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package mapbox
+
+import (
+	"math"
+)
+
+// Coordinates count limits of routing endpoints.
+const (
+	MaxDirectionsCoordinates    = 25
+	MaxMatrixCoordinates        = 25
+	MaxMatrixTrafficCoordinates = 10
+	MaxOptimizationCoordinates  = 12
+	MaxMapMatchingCoordinates   = 100
+)
+
+// ValidateCoordinates checks points before they are sent to directions or matrix API,
+// which otherwise respond with hard to read 422 errors.
+// It fails if there are less than 2 or more than max points, a point is NaN, infinite or out of range,
+// or two consecutive points are equal, DedupeCoordinates could be used to drop them.
+func ValidateCoordinates(points []GeoPoint, max int) error {
+	if len(points) < 2 {
+		return validationErrorf("Coordinates", ConstraintMinItems, "at least 2 coordinates are required, got %d", len(points))
+	}
+	if len(points) > max {
+		return validationErrorf("Coordinates", ConstraintMaxItems, "too many coordinates %d, max is %d", len(points), max)
+	}
+
+	for i, p := range points {
+		if !validCoordinate(p.Lon, 180) || !validCoordinate(p.Lat, 90) {
+			return validationErrorf(indexField("Coordinates", i), ConstraintRange, "invalid coordinate %d: %v,%v", i, p.Lon, p.Lat)
+		}
+		if i > 0 && p == points[i-1] {
+			return validationErrorf(indexField("Coordinates", i), ConstraintDuplicate, "coordinate %d duplicates the previous one: %v,%v", i, p.Lon, p.Lat)
+		}
+	}
+
+	return nil
+}
+
+// DedupeCoordinates returns points without consecutive duplicates.
+func DedupeCoordinates(points []GeoPoint) []GeoPoint {
+	deduped := make([]GeoPoint, 0, len(points))
+	for i, p := range points {
+		if i > 0 && p == points[i-1] {
+			continue
+		}
+		deduped = append(deduped, p)
+	}
+	return deduped
+}
+
+func validCoordinate(v, max float64) bool {
+	return !math.IsNaN(v) && v >= -max && v <= max
+}
@@ -0,0 +1,37 @@
+package mapbox
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestValidateCoordinates(t *testing.T) {
+	a, b := GeoPoint{Lon: 13.4, Lat: 52.5}, GeoPoint{Lon: 13.5, Lat: 52.6}
+
+	tests := []struct {
+		name    string
+		points  []GeoPoint
+		max     int
+		wantErr bool
+	}{
+		{name: "valid", points: []GeoPoint{a, b, a}, max: MaxDirectionsCoordinates},
+		{name: "single point", points: []GeoPoint{a}, max: MaxDirectionsCoordinates, wantErr: true},
+		{name: "too many", points: []GeoPoint{a, b, a}, max: 2, wantErr: true},
+		{name: "nan", points: []GeoPoint{a, {Lon: math.NaN()}}, max: MaxDirectionsCoordinates, wantErr: true},
+		{name: "inf", points: []GeoPoint{a, {Lat: math.Inf(1)}}, max: MaxDirectionsCoordinates, wantErr: true},
+		{name: "out of range", points: []GeoPoint{a, {Lon: 181}}, max: MaxDirectionsCoordinates, wantErr: true},
+		{name: "consecutive duplicates", points: []GeoPoint{a, b, b}, max: MaxDirectionsCoordinates, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateCoordinates(tt.points, tt.max); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCoordinates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got := DedupeCoordinates([]GeoPoint{a, a, b, b, a}); !reflect.DeepEqual(got, []GeoPoint{a, b, a}) {
+		t.Errorf("DedupeCoordinates() = %v", got)
+	}
+}
@@ -0,0 +1,235 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	defaultUpsertConcurrency = 4
+	defaultUpsertMaxRetries  = 3
+	defaultUpsertBackoff     = time.Second
+)
+
+var (
+	putMethod = []byte("PUT")
+)
+
+// DatasetFeature is a GeoJSON feature stored in a dataset.
+type DatasetFeature struct {
+	// ID of the feature in the dataset, must match GeoJSON id if it is set.
+	ID string
+	// GeoJSON is a raw GeoJSON Feature object.
+	GeoJSON []byte
+}
+
+// PutDatasetFeatureResponse wraps stored feature.
+type PutDatasetFeatureResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response, stored GeoJSON feature
+	RawResp []byte
+}
+
+// UpsertOptions tunes UpsertFeatures.
+type UpsertOptions struct {
+	// Concurrency is a max number of in-flight requests, default 4.
+	Concurrency int
+	// MaxRetries is a max number of retries of a single feature on 429 Too Many Requests, default 3,
+	// negative disables retries.
+	MaxRetries int
+	// Backoff is an initial delay between retries, doubled each retry, default 1s.
+	// X-Rate-Limit-Reset response header is preferred if present.
+	Backoff time.Duration
+}
+
+// UpsertReport summarizes UpsertFeatures results per feature ID, IDs are unique in a batch.
+type UpsertReport struct {
+	Succeeded []string
+	Failed    map[string]error
+}
+
+// Datasets covers mapbox datasets API.
+type Datasets interface {
+	// PutFeature calls datasets/v1 insert or update feature mapbox API
+	PutFeature(ctx context.Context, datasetID string, feature *DatasetFeature, opts ...CallOption) (*PutDatasetFeatureResponse, error)
+	// UpsertFeatures writes many features with bounded concurrency and retries on rate limiting.
+	UpsertFeatures(ctx context.Context, datasetID string, features []DatasetFeature, opts UpsertOptions,
+		callOpts ...CallOption) *UpsertReport
+}
+
+// FastHttpDatasets is a fasthttp Datasets implementation
+type FastHttpDatasets struct {
+	config
+
+	datasetsAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// PutFeature calls datasets/v1 insert or update feature mapbox API thought fasthttp client.
+func (c *FastHttpDatasets) PutFeature(ctx context.Context, datasetID string, feature *DatasetFeature, opts ...CallOption) (*PutDatasetFeatureResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	resp, reqURI, err := c.putFeature(ctx, datasetID, feature)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("put dataset feature", reqURI, resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	return &PutDatasetFeatureResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+	}, nil
+}
+
+func (c *FastHttpDatasets) putFeature(ctx context.Context, datasetID string, feature *DatasetFeature) (*rawResponse, string, error) {
+	if datasetID == "" {
+		return nil, "", validationErrorf("datasetID", ConstraintRequired, "dataset id is required")
+	}
+	if feature.ID == "" {
+		return nil, "", validationErrorf("ID", ConstraintRequired, "feature id is required")
+	}
+	if c.username == "" {
+		return nil, "", errors.New("username is required, set it with Username option")
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.datasetsAPIURL.Write(buf, nil, url.PathEscape(datasetID), "/features/", url.PathEscape(feature.ID))
+
+	reqURI := buf.String()
+
+	c.logRequest(ctx, "put dataset feature", buf.Bytes(), nil,
+		logKeyDataset, datasetID, logKeyFeature, feature.ID)
+
+	resp, err := c.do(ctx, "put dataset feature", putMethod, buf.Bytes(), feature.GeoJSON)
+	if err != nil {
+		return nil, reqURI, err
+	}
+
+	c.logResponse(ctx, "put dataset feature", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	return resp, reqURI, nil
+}
+
+// UpsertFeatures puts features into dataset with at most opts.Concurrency requests in flight.
+// Features rejected with 429 Too Many Requests are retried up to opts.MaxRetries times.
+// It never fails as a whole, every feature error is reported in UpsertReport.Failed.
+// Features sharing an ID are not written, as the last write would win at random, their ID fails with
+// ConstraintDuplicate ValidationError. callOpts apply to every feature request.
+func (c *FastHttpDatasets) UpsertFeatures(ctx context.Context, datasetID string, features []DatasetFeature, opts UpsertOptions,
+	callOpts ...CallOption) *UpsertReport {
+	ctx = withCallOptions(ctx, callOpts)
+
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultUpsertConcurrency
+	}
+	switch {
+	case opts.MaxRetries == 0:
+		opts.MaxRetries = defaultUpsertMaxRetries
+	case opts.MaxRetries < 0:
+		opts.MaxRetries = 0
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = defaultUpsertBackoff
+	}
+
+	report := &UpsertReport{Failed: make(map[string]error)}
+
+	first := make(map[string]int, len(features))
+	for i := range features {
+		id := features[i].ID
+		if id == "" {
+			continue
+		}
+		j, ok := first[id]
+		if !ok {
+			first[id] = i
+			continue
+		}
+		if _, ok := report.Failed[id]; !ok {
+			report.Failed[id] = validationErrorf(indexField("features", i)+".ID", ConstraintDuplicate,
+				"feature id %q is duplicated by features %d and %d", id, j, i)
+		}
+	}
+
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, opts.Concurrency)
+
+	for i := range features {
+		f := &features[i]
+		if _, ok := report.Failed[f.ID]; ok && f.ID != "" {
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			report.Failed[f.ID] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := c.upsertWithRetry(ctx, datasetID, f, opts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				report.Failed[f.ID] = err
+				return
+			}
+			report.Succeeded = append(report.Succeeded, f.ID)
+		}()
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+func (c *FastHttpDatasets) upsertWithRetry(ctx context.Context, datasetID string, f *DatasetFeature, opts UpsertOptions) error {
+	var reqURI string
+	resp, err := c.retryRateLimited(ctx, opts.MaxRetries, opts.Backoff, func() (resp *rawResponse, err error) {
+		resp, reqURI, err = c.putFeature(ctx, datasetID, f)
+		return resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.statusCode != http.StatusOK {
+		return newStatusError("put dataset feature", reqURI, resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	return nil
+}
+
+func NewFastHttpDatasets(opts ...Option) *FastHttpDatasets {
+	c := FastHttpDatasets{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.datasetsAPIURL = c.endpointURL("/datasets/v1/", c.username, slash)
+
+	return &c
+}
@@ -0,0 +1,111 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpDatasets_UpsertFeatures(t *testing.T) {
+	mu := sync.Mutex{}
+	attempts := map[string]int{}
+
+	c := NewFastHttpDatasets(AccessToken("token"), Username("user"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			id := uri[strings.LastIndex(uri, "/")+1 : strings.Index(uri, "?")]
+
+			mu.Lock()
+			attempts[id]++
+			attempt := attempts[id]
+			mu.Unlock()
+
+			switch {
+			case id == "broken":
+				resp.SetStatusCode(fasthttp.StatusUnprocessableEntity)
+			case id == "throttled" && attempt == 1:
+				resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+			default:
+				resp.SetBody(req.Body())
+			}
+			return nil
+		})))
+
+	report := c.UpsertFeatures(context.Background(), "dataset", []DatasetFeature{
+		{ID: "a", GeoJSON: []byte(`{"type":"Feature"}`)},
+		{ID: "throttled", GeoJSON: []byte(`{"type":"Feature"}`)},
+		{ID: "broken", GeoJSON: []byte(`{}`)},
+	}, UpsertOptions{Concurrency: 2, Backoff: time.Millisecond})
+
+	sort.Strings(report.Succeeded)
+	if strings.Join(report.Succeeded, ",") != "a,throttled" {
+		t.Errorf("unexpected succeeded %v", report.Succeeded)
+	}
+	if len(report.Failed) != 1 || report.Failed["broken"] == nil {
+		t.Errorf("unexpected failed %v", report.Failed)
+	}
+	if attempts["throttled"] != 2 || attempts["broken"] != 1 {
+		t.Errorf("unexpected attempts %v", attempts)
+	}
+
+	attempts = map[string]int{}
+	report = c.UpsertFeatures(context.Background(), "dataset", []DatasetFeature{
+		{ID: "a", GeoJSON: []byte(`{"type":"Feature"}`)},
+		{ID: "dup", GeoJSON: []byte(`{"type":"Feature","properties":{"v":1}}`)},
+		{ID: "dup", GeoJSON: []byte(`{"type":"Feature","properties":{"v":2}}`)},
+	}, UpsertOptions{})
+	var verr *ValidationError
+	if !errors.As(report.Failed["dup"], &verr) || verr.Constraint != ConstraintDuplicate || verr.Field != "features[2].ID" {
+		t.Errorf("duplicate id error expected, got %v", report.Failed["dup"])
+	}
+	if len(report.Succeeded) != 1 || attempts["dup"] != 0 {
+		t.Errorf("duplicated features must not be written, succeeded %v, attempts %v", report.Succeeded, attempts)
+	}
+
+	attempts = map[string]int{}
+	report = c.UpsertFeatures(context.Background(), "dataset", []DatasetFeature{
+		{ID: "throttled", GeoJSON: []byte(`{"type":"Feature"}`)},
+	}, UpsertOptions{MaxRetries: -1}, WithTimeout(time.Second))
+	if len(report.Failed) != 1 || attempts["throttled"] != 1 {
+		t.Errorf("retries must be disabled, failed %v, attempts %v", report.Failed, attempts)
+	}
+}
+
+func TestFastHttpDatasets_PutFeature(t *testing.T) {
+	var uri string
+	c := NewFastHttpDatasets(AccessToken("token"), Username("user"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBody(req.Body())
+			return nil
+		})))
+
+	feature := &DatasetFeature{ID: "a/b", GeoJSON: []byte(`{"type":"Feature"}`)}
+	if _, err := c.PutFeature(context.Background(), "data set", feature); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(uri, "/datasets/v1/user/data%20set/features/a%2Fb?access_token=token") {
+		t.Errorf("unexpected uri %s", uri)
+	}
+
+	tests := []struct {
+		datasetID string
+		featureID string
+		want      string
+	}{
+		{featureID: "a", want: "dataset id is required"},
+		{datasetID: "dataset", want: "feature id is required"},
+	}
+	for _, tt := range tests {
+		_, err := c.PutFeature(context.Background(), tt.datasetID, &DatasetFeature{ID: tt.featureID})
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("PutFeature(%q, %q) error = %v, want %s", tt.datasetID, tt.featureID, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package mapbox
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// DecodeMode defines how responses not matching the expected schema are handled.
+type DecodeMode int
+
+const (
+	// DecodeModeLenient skips unknown fields and zero fills missing ones.
+	DecodeModeLenient DecodeMode = iota
+	// DecodeModeStrict fails geocode calls if response has unknown or misses required fields,
+	// useful to detect mapbox API schema drift in canary environments.
+	DecodeModeStrict
+)
+
+// Decoding sets response decoding mode, default to DecodeModeLenient.
+func Decoding(m DecodeMode) Option {
+	return func(c config) config {
+		c.decodeMode = m
+		return c
+	}
+}
+
+// responseSchema describes a JSON object checked in strict decode mode.
+type responseSchema struct {
+	// required fields must be present.
+	required []string
+	// optional fields are known but could be omitted, trailing * matches any suffix, e.g. text_*.
+	optional []string
+	// nested are schemas of object or array of objects fields.
+	nested map[string]*responseSchema
+}
+
+var (
+	contextSchema = &responseSchema{
+		required: []string{"id", "text"},
+		optional: []string{"wikidata", "short_code", "text_*", "language", "language_*"},
+	}
+
+	featureSchema = &responseSchema{
+		required: []string{"id", "type", "place_type", "relevance", "properties", "text", "place_name", "center", "geometry"},
+		optional: []string{"address", "context", "bbox", "routable_points", "matching_text", "matching_place_name",
+			"text_*", "place_name_*", "language", "language_*"},
+		nested: map[string]*responseSchema{
+			"context": contextSchema,
+		},
+	}
+
+	geocodeSchema = &responseSchema{
+		required: []string{"type", "query", "features"},
+		optional: []string{"attribution"},
+		nested: map[string]*responseSchema{
+			"features": featureSchema,
+		},
+	}
+)
+
+// checkSchema validates body against schema in strict decode mode.
+func (c *config) checkSchema(s *responseSchema, body []byte) error {
+	if c.decodeMode != DecodeModeStrict {
+		return nil
+	}
+
+	var unknown, missing []string
+
+	in := jlexer.Lexer{Data: body}
+	s.check(&in, "", &unknown, &missing)
+	if err := in.Error(); err != nil {
+		return errorf("failed to check response schema: %w", err)
+	}
+
+	if len(unknown) == 0 && len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+	sort.Strings(missing)
+
+	return errorf("response schema mismatch: unknown fields [%s] missing fields [%s]", strings.Join(unknown, ","), strings.Join(missing, ","))
+}
+
+// check walks JSON object and collects unknown and missing fields paths.
+func (s *responseSchema) check(in *jlexer.Lexer, path string, unknown, missing *[]string) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+
+	seen := make(map[string]bool, len(s.required))
+
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.String()
+		in.WantColon()
+		seen[key] = true
+
+		switch nested := s.nested[key]; {
+		case nested != nil:
+			nested.checkValue(in, path+key, unknown, missing)
+		case s.known(key):
+			in.SkipRecursive()
+		default:
+			*unknown = append(*unknown, path+key)
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+
+	for _, f := range s.required {
+		if !seen[f] {
+			*missing = append(*missing, path+f)
+		}
+	}
+}
+
+// checkValue checks object or every object of array.
+func (s *responseSchema) checkValue(in *jlexer.Lexer, path string, unknown, missing *[]string) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+
+	if !in.IsDelim('[') {
+		s.check(in, path+".", unknown, missing)
+		return
+	}
+
+	in.Delim('[')
+	for i := 0; !in.IsDelim(']'); i++ {
+		s.check(in, path+"["+strconv.Itoa(i)+"].", unknown, missing)
+		in.WantComma()
+	}
+	in.Delim(']')
+}
+
+func (s *responseSchema) known(key string) bool {
+	for _, f := range s.required {
+		if f == key {
+			return true
+		}
+	}
+	for _, f := range s.optional {
+		if f == key || strings.HasSuffix(f, "*") && strings.HasPrefix(key, f[:len(f)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Endpoint identifies an API call custom decoders and request budgets are registered for,
+// it is also Meta.Endpoint and AccessRecord.Endpoint of the call.
+type Endpoint string
+
+// Geocoding endpoints.
+const (
+	EndpointReverseGeocode      Endpoint = "reverse geocode"
+	EndpointForwardGeocode      Endpoint = "forward geocode"
+	EndpointBatchReverseGeocode Endpoint = "batch reverse geocode"
+	EndpointForwardGeocodeV6    Endpoint = "forward geocode v6"
+	EndpointReverseGeocodeV6    Endpoint = "reverse geocode v6"
+	EndpointBatchGeocodeV6      Endpoint = "batch geocode v6"
+)
+
+// Navigation endpoints.
+const (
+	EndpointDirections           Endpoint = "directions"
+	EndpointIsochrone            Endpoint = "isochrone"
+	EndpointMapMatching          Endpoint = "map matching"
+	EndpointOptimizeTrip         Endpoint = "optimize trip"
+	EndpointSubmitRoutingProblem Endpoint = "submit routing problem"
+	EndpointRoutingSolution      Endpoint = "routing solution"
+)
+
+// Search Box endpoints.
+const (
+	EndpointSuggest        Endpoint = "suggest"
+	EndpointRetrieve       Endpoint = "retrieve"
+	EndpointCategorySearch Endpoint = "category search"
+)
+
+// Maps endpoints.
+const (
+	EndpointListStyles          Endpoint = "list styles"
+	EndpointStaticImage         Endpoint = "static image"
+	EndpointStaticTile          Endpoint = "static tile"
+	EndpointTileJSON            Endpoint = "tilejson"
+	EndpointVectorTile          Endpoint = "vector tile"
+	EndpointTilequery           Endpoint = "tilequery"
+	EndpointPutDatasetFeature   Endpoint = "put dataset feature"
+	EndpointUploadStatus        Endpoint = "upload status"
+	EndpointListTilesets        Endpoint = "list tilesets"
+	EndpointUploadTilesetSource Endpoint = "upload tileset source"
+	EndpointValidateRecipe      Endpoint = "validate recipe"
+	EndpointCreateTileset       Endpoint = "create tileset"
+	EndpointPublishTileset      Endpoint = "publish tileset"
+	EndpointTilesetJob          Endpoint = "get tileset job"
+)
+
+// Decoder maps raw response body straight into a user defined type.
+type Decoder func(body []byte) (interface{}, error)
+
+// CustomDecoder registers decoder for endpoint successful responses.
+// SDK skips its own decoding for the endpoint and returns decoded value in response Decoded field,
+// only RateLimit, Meta and RawResp are set besides it, and Permanent for geocode endpoints.
+// SDK filters such as FilterFeatures thresholds and GeofenceResults are not applied to custom decoded responses.
+func CustomDecoder(e Endpoint, d Decoder) Option {
+	return func(c config) config {
+		decoders := make(map[Endpoint]Decoder, len(c.decoders)+1)
+		for k, v := range c.decoders {
+			decoders[k] = v
+		}
+		decoders[e] = d
+		c.decoders = decoders
+		return c
+	}
+}
+
+// customDecode decodes body with decoder registered for endpoint, ok is false if there is none.
+func (c *config) customDecode(e Endpoint, body []byte) (v interface{}, ok bool, err error) {
+	d, ok := c.decoders[e]
+	if !ok {
+		return nil, false, nil
+	}
+
+	v, err = d(body)
+	if err != nil {
+		return nil, true, errorf("failed to decode %s resp %s: %w", e, string(body), err)
+	}
+
+	return v, true, nil
+}
+
+// SoftFailDecoding returns successfully decoded geocode features along with per feature decode errors
+// in GeocodeResponse.DecodeErrors instead of failing the whole response, default to false.
+// The response still fails if it is not a valid JSON.
+func SoftFailDecoding(soft bool) Option {
+	return func(c config) config {
+		c.softFail = soft
+		return c
+	}
+}
+
+// FeatureDecodeError describes a feature skipped by soft fail decoding.
+type FeatureDecodeError struct {
+	// Index of the feature in response features array.
+	Index int
+	// Raw feature JSON.
+	Raw []byte
+	Err error
+}
+
+func (e *FeatureDecodeError) Error() string {
+	return "failed to decode feature " + strconv.Itoa(e.Index) + ": " + e.Err.Error()
+}
+
+// unmarshalGeocodeFields walks geocode response object calling features and query decoders for the fields.
+func unmarshalGeocodeFields(body []byte, features, query func(in *jlexer.Lexer)) error {
+	in := jlexer.Lexer{Data: body}
+
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		switch key {
+		case "features":
+			features(&in)
+		case "query":
+			query(&in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	in.Consumed()
+
+	return in.Error()
+}
+
+// unmarshalFeaturesSoft decodes every feature separately collecting decode errors.
+func unmarshalFeaturesSoft(in *jlexer.Lexer) ([]Feature, []FeatureDecodeError) {
+	if in.IsNull() {
+		in.Skip()
+		return nil, nil
+	}
+
+	var (
+		features []Feature
+		errs     []FeatureDecodeError
+	)
+
+	in.Delim('[')
+	for i := 0; !in.IsDelim(']') && in.Ok(); i++ {
+		raw := in.Raw()
+
+		f := Feature{}
+		if err := f.UnmarshalJSON(raw); err != nil {
+			errs = append(errs, FeatureDecodeError{Index: i, Raw: raw, Err: err})
+		} else {
+			features = append(features, f)
+		}
+		in.WantComma()
+	}
+	in.Delim(']')
+
+	return features, errs
+}
@@ -0,0 +1,120 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func Test_config_checkSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    DecodeMode
+		body    string
+		wantErr string
+	}{
+		{
+			name: "lenient",
+			body: `{"foo":1}`,
+		},
+		{
+			name: "strict test response",
+			mode: DecodeModeStrict,
+			body: string(testRespBody),
+		},
+		{
+			name:    "strict unknown and missing",
+			mode:    DecodeModeStrict,
+			body:    `{"type":"FeatureCollection","query":[1,2],"foo":1,"features":[{"id":"place.1","text":"a","text_de":"b","context":[{"id":"country.1","bar":null}]}]}`,
+			wantErr: "response schema mismatch: unknown fields [features[0].context[0].bar,foo] missing fields [features[0].center,features[0].context[0].text,features[0].geometry,features[0].place_name,features[0].place_type,features[0].properties,features[0].relevance,features[0].type]",
+		},
+		{
+			name:    "strict invalid json",
+			mode:    DecodeModeStrict,
+			body:    `[]`,
+			wantErr: "failed to check response schema",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newConfig()
+			c = Decoding(tt.mode)(c)
+
+			err := c.checkSchema(geocodeSchema, []byte(tt.body))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkSchema() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("checkSchema() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomDecoder(t *testing.T) {
+	type place struct {
+		name string
+	}
+
+	c := NewFastHttpGeocoder(
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.SetBody(testRespBody)
+			return nil
+		})),
+		CustomDecoder(EndpointReverseGeocode, func(body []byte) (interface{}, error) {
+			return place{name: strconv.Itoa(len(body))}, nil
+		}),
+		CustomDecoder(EndpointForwardGeocode, func(body []byte) (interface{}, error) {
+			return nil, errors.New("boom")
+		}),
+	)
+
+	resp, err := c.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{GeoPoint: GeoPoint{Lon: -77.036547, Lat: 38.897675}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Decoded != (place{name: strconv.Itoa(len(testRespBody))}) || resp.Features != nil || len(resp.RawResp) == 0 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+
+	if _, err := c.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "washington"}); err == nil {
+		t.Error("decoder error expected")
+	}
+}
+
+func TestSoftFailDecoding(t *testing.T) {
+	client := HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		resp.SetBodyString(`{"type":"FeatureCollection","query":["a"],"features":[
+			{"id":"place.1","relevance":1},
+			{"id":"place.2","relevance":"high"},
+			{"id":"place.3","center":[1,2]}
+		]}`)
+		return nil
+	}))
+
+	if _, err := NewFastHttpGeocoder(client).ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "a"}); err == nil {
+		t.Fatal("decode error expected without soft fail")
+	}
+
+	for _, reuse := range []bool{false, true} {
+		g := NewFastHttpGeocoder(client, SoftFailDecoding(true), ReuseContexts(reuse))
+		resp, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "a"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(resp.Features) != 2 || resp.Features[0].ID != "place.1" || resp.Features[1].ID != "place.3" || resp.Query.Text != "a" {
+			t.Errorf("unexpected features %+v", resp.Features)
+		}
+		if len(resp.DecodeErrors) != 1 || resp.DecodeErrors[0].Index != 1 || !strings.Contains(string(resp.DecodeErrors[0].Raw), "place.2") {
+			t.Errorf("unexpected decode errors %+v", resp.DecodeErrors)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package mapbox
+
+// FeatureChangeKind is a kind of feature difference between two geocode responses.
+type FeatureChangeKind string
+
+const (
+	FeatureAdded   FeatureChangeKind = "added"
+	FeatureRemoved FeatureChangeKind = "removed"
+	FeatureMoved   FeatureChangeKind = "moved"
+	FeatureRenamed FeatureChangeKind = "renamed"
+)
+
+// FeatureChange is a single feature difference, a feature could be both moved and renamed.
+type FeatureChange struct {
+	Kind FeatureChangeKind
+	ID   string
+	// OldPlaceName is empty for added features.
+	OldPlaceName string
+	// NewPlaceName is empty for removed features.
+	NewPlaceName string
+	// Moved is the distance between old and new centers in meters, set for moved features only.
+	Moved float64
+}
+
+// DiffGeocodeResponses compares responses of the same query, e.g. a cached and a revalidated one,
+// and returns semantic differences of features matched by ID.
+// Centers moved less than minMove meters are not reported, so coordinates rounding is ignored.
+// Removed, moved and renamed features follow old response order, added ones follow fresh response order.
+func DiffGeocodeResponses(old, fresh *GeocodeResponse, minMove float64) []FeatureChange {
+	newByID := make(map[string]*Feature, len(fresh.Features))
+	for i := range fresh.Features {
+		newByID[fresh.Features[i].ID] = &fresh.Features[i]
+	}
+
+	var changes []FeatureChange
+
+	oldIDs := make(map[string]bool, len(old.Features))
+	for i := range old.Features {
+		o := &old.Features[i]
+		oldIDs[o.ID] = true
+
+		n, ok := newByID[o.ID]
+		if !ok {
+			changes = append(changes, FeatureChange{Kind: FeatureRemoved, ID: o.ID, OldPlaceName: o.PlaceName})
+			continue
+		}
+
+		if len(o.Center) >= 2 && len(n.Center) >= 2 {
+			if d := Distance(featureCenter(o), featureCenter(n)); d > 0 && d >= minMove {
+				changes = append(changes, FeatureChange{
+					Kind: FeatureMoved, ID: o.ID, OldPlaceName: o.PlaceName, NewPlaceName: n.PlaceName, Moved: d,
+				})
+			}
+		}
+		if o.PlaceName != n.PlaceName {
+			changes = append(changes, FeatureChange{
+				Kind: FeatureRenamed, ID: o.ID, OldPlaceName: o.PlaceName, NewPlaceName: n.PlaceName,
+			})
+		}
+	}
+
+	for i := range fresh.Features {
+		if n := &fresh.Features[i]; !oldIDs[n.ID] {
+			changes = append(changes, FeatureChange{Kind: FeatureAdded, ID: n.ID, NewPlaceName: n.PlaceName})
+		}
+	}
+
+	return changes
+}
+
+// featureCenter returns feature center, it must be checked to have 2 coordinates.
+func featureCenter(f *Feature) GeoPoint {
+	return GeoPoint{Lon: f.Center[0], Lat: f.Center[1]}
+}
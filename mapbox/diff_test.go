@@ -0,0 +1,40 @@
+package mapbox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffGeocodeResponses(t *testing.T) {
+	old := &GeocodeResponse{Features: []Feature{
+		{ID: "address.1", PlaceName: "1 Main Street", Center: []float64{10, 50}},
+		{ID: "address.2", PlaceName: "2 Main Street", Center: []float64{10, 50}},
+		{ID: "place.1", PlaceName: "Springfield", Center: []float64{10, 50}},
+		{ID: "poi.1", PlaceName: "Cafe", Center: []float64{10, 50}},
+	}}
+	revalidated := &GeocodeResponse{Features: []Feature{
+		{ID: "poi.2", PlaceName: "Bakery", Center: []float64{10, 50}},
+		{ID: "place.1", PlaceName: "Springfield City", Center: []float64{10, 50.01}},
+		{ID: "address.2", PlaceName: "2 Main Street", Center: []float64{10.000001, 50}},
+		{ID: "address.1", PlaceName: "1 Main Street", Center: []float64{10, 50}},
+	}}
+
+	got := DiffGeocodeResponses(old, revalidated, 10)
+	for i := range got {
+		got[i].Moved = float64(int(got[i].Moved))
+	}
+
+	want := []FeatureChange{
+		{Kind: FeatureMoved, ID: "place.1", OldPlaceName: "Springfield", NewPlaceName: "Springfield City", Moved: 1111},
+		{Kind: FeatureRenamed, ID: "place.1", OldPlaceName: "Springfield", NewPlaceName: "Springfield City"},
+		{Kind: FeatureRemoved, ID: "poi.1", OldPlaceName: "Cafe"},
+		{Kind: FeatureAdded, ID: "poi.2", NewPlaceName: "Bakery"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if changes := DiffGeocodeResponses(old, old, 0); len(changes) != 0 {
+		t.Errorf("no changes expected, got %+v", changes)
+	}
+}
@@ -0,0 +1,828 @@
+package mapbox
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+const (
+	alternatives       = "alternatives"
+	annotations        = "annotations"
+	approaches         = "approaches"
+	bannerInstructions = "banner_instructions"
+	bearings           = "bearings"
+	engine             = "engine"
+	evChargingCurve    = "ev_charging_curve"
+	evConnectorTypes   = "ev_connector_types"
+	evConsumptionCurve = "energy_consumption_curve"
+	evInitialCharge    = "ev_initial_charge"
+	evMaxCharge        = "ev_max_charge"
+	evMinChargeAtDest  = "ev_min_charge_at_destination"
+	evMinChargeAtStop  = "ev_min_charge_at_charging_station"
+	radiuses           = "radiuses"
+	waypointNames      = "waypoint_names"
+	geometries         = "geometries"
+	overview           = "overview"
+	steps              = "steps"
+	voiceInstructions  = "voice_instructions"
+	voiceUnits         = "voice_units"
+)
+
+// DirectionsProfile is a routing profile of directions, matrix and map matching APIs.
+type DirectionsProfile string
+
+const (
+	ProfileDriving        DirectionsProfile = "driving"
+	ProfileDrivingTraffic DirectionsProfile = "driving-traffic"
+	ProfileWalking        DirectionsProfile = "walking"
+	ProfileCycling        DirectionsProfile = "cycling"
+)
+
+// Route geometry formats.
+const (
+	GeometriesGeoJSON   = "geojson"
+	GeometriesPolyline  = "polyline"
+	GeometriesPolyline6 = "polyline6"
+)
+
+// Polyline precisions of GeometriesPolyline and GeometriesPolyline6.
+const (
+	polylinePrecision  = 5
+	polyline6Precision = 6
+)
+
+// Route overview geometry detail levels.
+const (
+	OverviewFull       = "full"
+	OverviewSimplified = "simplified"
+	OverviewFalse      = "false"
+)
+
+// Route annotations, see DirectionsRequest.Annotations.
+const (
+	AnnotationDuration   = "duration"
+	AnnotationDistance   = "distance"
+	AnnotationSpeed      = "speed"
+	AnnotationCongestion = "congestion"
+	AnnotationMaxSpeed   = "maxspeed"
+	// AnnotationNodes returns OSM node ids of every geometry coordinate, see LegAnnotation.Segments.
+	AnnotationNodes = "nodes"
+)
+
+// Congestion levels of AnnotationCongestion.
+const (
+	CongestionUnknown  = "unknown"
+	CongestionLow      = "low"
+	CongestionModerate = "moderate"
+	CongestionHeavy    = "heavy"
+	CongestionSevere   = "severe"
+)
+
+// Voice instructions units, see DirectionsRequest.VoiceUnits.
+const (
+	VoiceUnitsImperial = "imperial"
+	VoiceUnitsMetric   = "metric"
+)
+
+// Waypoint approaches, see DirectionsRequest.Approaches.
+const (
+	ApproachUnrestricted = "unrestricted"
+	ApproachCurb         = "curb"
+)
+
+// Bearing limits road direction a waypoint is snapped to.
+type Bearing struct {
+	// Angle is clockwise from true north, 0 to 360.
+	Angle int
+	// Range is allowed deviation from Angle, 0 to 180.
+	Range int
+}
+
+// EV connector types, see EVParams.ConnectorTypes.
+const (
+	ConnectorCCSCombo1 = "ccs_combo_type1"
+	ConnectorCCSCombo2 = "ccs_combo_type2"
+	ConnectorTesla     = "tesla"
+	ConnectorCHAdeMO   = "chademo"
+)
+
+// WaypointChargingStation is a metadata type of charging stops added to electric vehicle routes.
+const WaypointChargingStation = "charging-station"
+
+// EVParams requests electric vehicle routing, charging stops are added to the route as waypoints.
+// Charges are in Wh.
+type EVParams struct {
+	InitialCharge int
+	MaxCharge     int
+	// ConnectorTypes the vehicle supports, e.g. ConnectorCCSCombo2, mapbox default is any.
+	ConnectorTypes []string
+	// ChargingCurve is a charging power in W at a charge, ordered by charge.
+	ChargingCurve []EVCurvePoint
+	// ConsumptionCurve is an energy consumption in Wh per km at a speed in km/h, ordered by speed.
+	ConsumptionCurve []EVCurvePoint
+	// MinChargeAtDestination and MinChargeAtChargingStation are charge reserves, zero keeps mapbox defaults.
+	MinChargeAtDestination     int
+	MinChargeAtChargingStation int
+}
+
+// EVCurvePoint is a point of EVParams curves.
+type EVCurvePoint struct {
+	X float64
+	Y float64
+}
+
+// Directions response codes.
+const (
+	DirectionsCodeOk      = "Ok"
+	DirectionsCodeNoRoute = "NoRoute"
+)
+
+// DirectionsRequest describes directions/v5 request.
+type DirectionsRequest struct {
+	// Profile default to ProfileDriving.
+	Profile DirectionsProfile
+	// Coordinates of 2 to MaxDirectionsCoordinates waypoints.
+	Coordinates []GeoPoint
+	// Alternatives requests up to 2 alternative routes.
+	Alternatives bool
+	// Geometries format, mapbox default is GeometriesPolyline.
+	Geometries string
+	// Overview geometry detail level, mapbox default is OverviewSimplified.
+	Overview string
+	// Steps requests turn-by-turn instructions.
+	Steps bool
+	// Language of instructions, default to language set with WithContextLanguage.
+	Language string
+	// VoiceInstructions and BannerInstructions request turn-by-turn UI instructions on every step,
+	// they require Steps.
+	VoiceInstructions  bool
+	BannerInstructions bool
+	// VoiceUnits of voice instructions distances, e.g. VoiceUnitsMetric, mapbox default depends on Language.
+	VoiceUnits string
+	// Annotations requests per segment metadata returned in RouteLeg.Annotation, e.g. AnnotationCongestion.
+	// Segments are ones of the full geometry, so it should be requested with OverviewFull.
+	Annotations []string
+
+	// Per coordinate waypoint controls, lists must be empty or have an item for every coordinate.
+	// Bearings nil items, zero Radiuses and empty Approaches and WaypointNames keep mapbox defaults.
+	Bearings []*Bearing
+	// Radiuses in meters a coordinate could be snapped within, math.Inf(1) is unlimited.
+	Radiuses []float64
+	// Approaches sets side of the road to approach a waypoint from, e.g. ApproachCurb.
+	Approaches []string
+	// WaypointNames are returned in route instructions instead of snapped street names.
+	WaypointNames []string
+
+	// EV requests electric vehicle routing, it requires ProfileDrivingTraffic.
+	EV *EVParams
+}
+
+// PresetCarNavigation returns a request for turn-by-turn car navigation in traffic: full overview with
+// congestion, duration and distance annotations, steps with voice and banner instructions.
+func PresetCarNavigation(coordinates ...GeoPoint) *DirectionsRequest {
+	return &DirectionsRequest{
+		Profile:            ProfileDrivingTraffic,
+		Coordinates:        coordinates,
+		Geometries:         GeometriesPolyline6,
+		Overview:           OverviewFull,
+		Steps:              true,
+		VoiceInstructions:  true,
+		BannerInstructions: true,
+		Annotations:        []string{AnnotationCongestion, AnnotationDuration, AnnotationDistance},
+	}
+}
+
+// PresetDeliveryTruckEconomy returns a request for planning delivery runs without traffic: full overview with
+// duration and distance annotations to cost legs, steps without UI instructions.
+func PresetDeliveryTruckEconomy(coordinates ...GeoPoint) *DirectionsRequest {
+	return &DirectionsRequest{
+		Profile:     ProfileDriving,
+		Coordinates: coordinates,
+		Geometries:  GeometriesPolyline6,
+		Overview:    OverviewFull,
+		Steps:       true,
+		Annotations: []string{AnnotationDuration, AnnotationDistance},
+	}
+}
+
+// PresetPedestrian returns a walking request with simplified overview and steps with banner instructions.
+func PresetPedestrian(coordinates ...GeoPoint) *DirectionsRequest {
+	return &DirectionsRequest{
+		Profile:            ProfileWalking,
+		Coordinates:        coordinates,
+		Geometries:         GeometriesPolyline6,
+		Overview:           OverviewSimplified,
+		Steps:              true,
+		BannerInstructions: true,
+	}
+}
+
+// RouteGeometry is either an encoded polyline or GeoJSON LineString depending on requested Geometries.
+type RouteGeometry struct {
+	// Polyline is set for polyline and polyline6 geometries.
+	Polyline string
+	// Precision of Polyline is 6 for polyline6 geometries requested with Directions or OptimizeTrip,
+	// zero is 5, mapbox default.
+	Precision int
+	// Type and Coordinates are set for GeoJSON geometries.
+	Type        string
+	Coordinates [][]float64
+}
+
+// easyjson:json
+type lineGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates [][]float64 `json:"coordinates"`
+}
+
+// LineString returns geometry coordinates, polylines are decoded with Precision.
+// It is empty if there is no geometry, e.g. with OverviewFalse, or the polyline is malformed.
+func (g RouteGeometry) LineString() LineString {
+	if g.Type == "" {
+		precision := g.Precision
+		if precision == 0 {
+			precision = polylinePrecision
+		}
+		line, _ := DecodePolyline(g.Polyline, precision)
+		return line
+	}
+
+	line := make(LineString, 0, len(g.Coordinates))
+	for _, c := range g.Coordinates {
+		if len(c) >= 2 {
+			line = append(line, GeoPoint{Lon: c[0], Lat: c[1]})
+		}
+	}
+	return line
+}
+
+// setPolyline6 marks route and step polylines as polyline6 ones if geometries is GeometriesPolyline6,
+// so LineString decodes them right.
+func setPolyline6(routes []Route, geometries string) {
+	if geometries != GeometriesPolyline6 {
+		return
+	}
+	for i := range routes {
+		r := &routes[i]
+		r.Geometry.Precision = polyline6Precision
+		for j := range r.Legs {
+			for k := range r.Legs[j].Steps {
+				r.Legs[j].Steps[k].Geometry.Precision = polyline6Precision
+			}
+		}
+	}
+}
+
+// UnmarshalEasyJSON reads polyline string or GeoJSON object.
+func (g *RouteGeometry) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	*g = RouteGeometry{}
+	switch {
+	case in.IsNull():
+		in.Skip()
+	case in.IsDelim('{'):
+		l := lineGeometry{}
+		l.UnmarshalEasyJSON(in)
+		g.Type, g.Coordinates = l.Type, l.Coordinates
+	default:
+		g.Polyline = in.String()
+	}
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (g *RouteGeometry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	g.UnmarshalEasyJSON(&r)
+	return r.Error()
+}
+
+// MarshalEasyJSON writes geometry back the same way mapbox returns it.
+func (g RouteGeometry) MarshalEasyJSON(out *jwriter.Writer) {
+	if g.Type == "" {
+		out.String(g.Polyline)
+		return
+	}
+	lineGeometry{Type: g.Type, Coordinates: g.Coordinates}.MarshalEasyJSON(out)
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (g RouteGeometry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	g.MarshalEasyJSON(&w)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// Route is a route between waypoints, durations are in seconds and distances in meters.
+type Route struct {
+	Duration   float64       `json:"duration"`
+	Distance   float64       `json:"distance"`
+	Weight     float64       `json:"weight"`
+	WeightName string        `json:"weight_name"`
+	Geometry   RouteGeometry `json:"geometry"`
+	// Legs are routes between every two consecutive waypoints.
+	Legs []RouteLeg `json:"legs"`
+}
+
+// RouteLeg is a route between two waypoints.
+type RouteLeg struct {
+	Duration float64 `json:"duration"`
+	Distance float64 `json:"distance"`
+	Weight   float64 `json:"weight"`
+	Summary  string  `json:"summary"`
+	// Steps are set if requested.
+	Steps []RouteStep `json:"steps,omitempty"`
+	// Annotation is set if requested.
+	Annotation *LegAnnotation `json:"annotation,omitempty"`
+}
+
+// LegAnnotation holds requested metadata of every segment between two consecutive geometry coordinates.
+type LegAnnotation struct {
+	// Duration in seconds.
+	Duration []float64 `json:"duration,omitempty"`
+	// Distance in meters.
+	Distance []float64 `json:"distance,omitempty"`
+	// Speed in meters per second.
+	Speed []float64 `json:"speed,omitempty"`
+	// Congestion levels, e.g. CongestionHeavy.
+	Congestion []string   `json:"congestion,omitempty"`
+	MaxSpeed   []MaxSpeed `json:"maxspeed,omitempty"`
+	// Nodes are OSM node ids, there is one more node than segments.
+	Nodes []int64 `json:"nodes,omitempty"`
+}
+
+// MaxSpeed is a segment speed limit, Speed is set only if both Unknown and None are false.
+type MaxSpeed struct {
+	Speed float64 `json:"speed,omitempty"`
+	// Unit is SpeedUnitKMH or SpeedUnitMPH.
+	Unit string `json:"unit,omitempty"`
+	// Unknown is true if speed limit is not known.
+	Unknown bool `json:"unknown,omitempty"`
+	// None is true if there is no speed limit.
+	None bool `json:"none,omitempty"`
+}
+
+// MaxSpeed units.
+const (
+	SpeedUnitKMH = "km/h"
+	SpeedUnitMPH = "mph"
+)
+
+const kmPerMile = 1.609344
+
+// KMH returns speed limit in km/h, +Inf if there is no limit.
+// ok is false if speed limit is unknown or its unit is not recognized.
+func (s MaxSpeed) KMH() (speed float64, ok bool) {
+	return s.In(SpeedUnitKMH)
+}
+
+// MPH returns speed limit in mph, +Inf if there is no limit.
+// ok is false if speed limit is unknown or its unit is not recognized.
+func (s MaxSpeed) MPH() (speed float64, ok bool) {
+	return s.In(SpeedUnitMPH)
+}
+
+// In converts speed limit to unit, SpeedUnitKMH or SpeedUnitMPH, +Inf is returned if there is no limit.
+// ok is false if speed limit is unknown or either unit is not recognized.
+func (s MaxSpeed) In(unit string) (speed float64, ok bool) {
+	if s.Unknown || (unit != SpeedUnitKMH && unit != SpeedUnitMPH) {
+		return 0, false
+	}
+	if s.None {
+		return math.Inf(1), true
+	}
+
+	switch {
+	case s.Unit == unit:
+		return s.Speed, true
+	case s.Unit == SpeedUnitKMH:
+		return s.Speed / kmPerMile, true
+	case s.Unit == SpeedUnitMPH:
+		return s.Speed * kmPerMile, true
+	default:
+		return 0, false
+	}
+}
+
+// RouteStep is a single maneuver with the way to the next one.
+type RouteStep struct {
+	Duration float64       `json:"duration"`
+	Distance float64       `json:"distance"`
+	Name     string        `json:"name"`
+	Mode     string        `json:"mode"`
+	Geometry RouteGeometry `json:"geometry"`
+	Maneuver StepManeuver  `json:"maneuver"`
+	// VoiceInstructions and BannerInstructions are set if requested.
+	VoiceInstructions  []VoiceInstruction  `json:"voiceInstructions,omitempty"`
+	BannerInstructions []BannerInstruction `json:"bannerInstructions,omitempty"`
+}
+
+// VoiceInstruction is an announcement to be spoken at DistanceAlongGeometry meters before the step maneuver.
+type VoiceInstruction struct {
+	DistanceAlongGeometry float64 `json:"distanceAlongGeometry"`
+	Announcement          string  `json:"announcement"`
+	// SSMLAnnouncement is Announcement with SSML markup for text to speech engines.
+	SSMLAnnouncement string `json:"ssmlAnnouncement,omitempty"`
+}
+
+// BannerInstruction is a visual instruction to be shown from DistanceAlongGeometry meters before the step maneuver.
+type BannerInstruction struct {
+	DistanceAlongGeometry float64     `json:"distanceAlongGeometry"`
+	Primary               BannerText  `json:"primary"`
+	Secondary             *BannerText `json:"secondary,omitempty"`
+	// Sub is e.g. a lane guidance.
+	Sub *BannerText `json:"sub,omitempty"`
+}
+
+// BannerText is a banner line.
+type BannerText struct {
+	Text string `json:"text"`
+	// Type and Modifier are maneuver ones, e.g. turn and left.
+	Type     string `json:"type,omitempty"`
+	Modifier string `json:"modifier,omitempty"`
+	// Degrees of roundabout exit.
+	Degrees     float64           `json:"degrees,omitempty"`
+	DrivingSide string            `json:"driving_side,omitempty"`
+	Components  []BannerComponent `json:"components"`
+}
+
+// BannerComponent is a part of banner text, e.g. a road shield or a lane.
+type BannerComponent struct {
+	Text string `json:"text"`
+	// Type is e.g. text, icon, delimiter, exit or lane.
+	Type                 string `json:"type"`
+	Abbreviation         string `json:"abbr,omitempty"`
+	AbbreviationPriority int    `json:"abbr_priority,omitempty"`
+	// ImageBaseURL of a road shield.
+	ImageBaseURL string `json:"imageBaseURL,omitempty"`
+	// Directions and Active describe lane components.
+	Directions []string `json:"directions,omitempty"`
+	Active     bool     `json:"active,omitempty"`
+}
+
+// StepManeuver describes a turn-by-turn instruction.
+type StepManeuver struct {
+	Type        string `json:"type"`
+	Modifier    string `json:"modifier,omitempty"`
+	Instruction string `json:"instruction"`
+	// Location as lon,lat pair.
+	Location      []float64 `json:"location"`
+	BearingBefore float64   `json:"bearing_before"`
+	BearingAfter  float64   `json:"bearing_after"`
+}
+
+// DirectionsWaypoint is an input coordinate snapped to the road network.
+type DirectionsWaypoint struct {
+	// Name of the street the coordinate snapped to.
+	Name string `json:"name"`
+	// Snapped location as lon,lat pair.
+	Location []float64 `json:"location"`
+	// Distance in meters between the input coordinate and the snapped location.
+	Distance float64 `json:"distance"`
+	// Metadata is set for waypoints added by mapbox, e.g. electric vehicle charging stops.
+	Metadata *WaypointMetadata `json:"metadata,omitempty"`
+}
+
+// WaypointMetadata describes a waypoint added by mapbox, charges are in Wh and ChargeTime in seconds.
+type WaypointMetadata struct {
+	// Type is e.g. WaypointChargingStation.
+	Type            string  `json:"type"`
+	Name            string  `json:"name,omitempty"`
+	StationID       string  `json:"station_id,omitempty"`
+	ChargeTime      float64 `json:"charge_time,omitempty"`
+	ChargeAtArrival int     `json:"charge_at_arrival,omitempty"`
+	ChargeTo        int     `json:"charge_to,omitempty"`
+	PlugType        string  `json:"plug_type,omitempty"`
+	PowerKW         float64 `json:"power_kw,omitempty"`
+}
+
+// IsChargingStation reports whether waypoint is a charging stop added to electric vehicle route.
+func (w DirectionsWaypoint) IsChargingStation() bool {
+	return w.Metadata != nil && w.Metadata.Type == WaypointChargingStation
+}
+
+// GeoPoint returns snapped location.
+func (w DirectionsWaypoint) GeoPoint() GeoPoint {
+	if len(w.Location) < 2 {
+		return GeoPoint{}
+	}
+	return GeoPoint{Lon: w.Location[0], Lat: w.Location[1]}
+}
+
+// easyjson:json
+type rawDirectionsResp struct {
+	Code      string               `json:"code"`
+	Message   string               `json:"message,omitempty"`
+	Routes    []Route              `json:"routes"`
+	Waypoints []DirectionsWaypoint `json:"waypoints"`
+	UUID      string               `json:"uuid,omitempty"`
+}
+
+// DirectionsResponse wraps directions routes.
+type DirectionsResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	// Code is DirectionsCodeOk or DirectionsCodeNoRoute, Routes are empty for the latter.
+	Code      string
+	Routes    []Route
+	Waypoints []DirectionsWaypoint
+	UUID      string
+}
+
+// ChargingStations returns charging stops added to electric vehicle route.
+func (r *DirectionsResponse) ChargingStations() []DirectionsWaypoint {
+	var stations []DirectionsWaypoint
+	for _, w := range r.Waypoints {
+		if w.IsChargingStation() {
+			stations = append(stations, w)
+		}
+	}
+	return stations
+}
+
+// Directions covers mapbox directions API.
+type Directions interface {
+	// Directions calls directions/v5 mapbox API
+	Directions(ctx context.Context, req *DirectionsRequest, opts ...CallOption) (*DirectionsResponse, error)
+}
+
+// FastHttpDirections is a fasthttp Directions implementation, it is a PairRouter as well.
+type FastHttpDirections struct {
+	config
+
+	directionsAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// Directions calls directions/v5 mapbox API thought fasthttp client.
+func (c *FastHttpDirections) Directions(ctx context.Context, req *DirectionsRequest, opts ...CallOption) (*DirectionsResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := ValidateCoordinates(req.Coordinates, MaxDirectionsCoordinates); err != nil {
+		return nil, err
+	}
+	if (req.VoiceInstructions || req.BannerInstructions) && !req.Steps {
+		return nil, validationErrorf("Steps", ConstraintDepends, "voice and banner instructions require steps")
+	}
+
+	profile := req.Profile
+	if profile == "" {
+		profile = ProfileDriving
+	}
+
+	values := make(map[string]string, 21)
+	if req.Alternatives {
+		values[alternatives] = trueStr
+	}
+	if req.Geometries != "" {
+		values[geometries] = req.Geometries
+	}
+	if req.Overview != "" {
+		values[overview] = req.Overview
+	}
+	if req.Steps {
+		values[steps] = trueStr
+	}
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
+	}
+	if len(req.Annotations) > 0 {
+		values[annotations] = strings.Join(req.Annotations, ",")
+	}
+	if req.VoiceInstructions {
+		values[voiceInstructions] = trueStr
+	}
+	if req.BannerInstructions {
+		values[bannerInstructions] = trueStr
+	}
+	if req.VoiceUnits != "" {
+		values[voiceUnits] = req.VoiceUnits
+	}
+	if err := req.waypointValues(values); err != nil {
+		return nil, err
+	}
+	if req.EV != nil {
+		if profile != ProfileDrivingTraffic {
+			return nil, validationErrorf("Profile", ConstraintDepends, "electric vehicle routing requires %s profile", ProfileDrivingTraffic)
+		}
+		if err := req.EV.values(values); err != nil {
+			return nil, err
+		}
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.directionsAPIURL.Write(buf, values, string(profile), slash, formatCoordinates(req.Coordinates))
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "directions", reqURI, values, logKeyProfile, string(profile),
+		logKeyCoordinates, strconv.Itoa(len(req.Coordinates)))
+
+	resp, err := c.do(ctx, "directions", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "directions", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("get directions", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawDirectionsResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall directions resp %s: %w", string(resp.body), err)
+	}
+	if respRaw.Code != DirectionsCodeOk && respRaw.Code != DirectionsCodeNoRoute {
+		return nil, errorf("failed to get directions code %s message %s", respRaw.Code, respRaw.Message)
+	}
+	setPolyline6(respRaw.Routes, req.Geometries)
+
+	return &DirectionsResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Code:      respRaw.Code,
+		Routes:    respRaw.Routes,
+		Waypoints: respRaw.Waypoints,
+		UUID:      respRaw.UUID,
+	}, nil
+}
+
+// ETA returns duration in seconds and distance in meters of the route between two points with profile,
+// default to ProfileDriving. It requests no geometry, steps or annotations, so responses stay small.
+// found is false when there is no route between the points.
+func (c *FastHttpDirections) ETA(ctx context.Context, from, to GeoPoint, profile DirectionsProfile,
+	opts ...CallOption) (duration, distance float64, found bool, err error) {
+	ctx = withCallOptions(ctx, opts)
+
+	resp, err := c.Directions(ctx, &DirectionsRequest{
+		Profile:     profile,
+		Coordinates: []GeoPoint{from, to},
+		Overview:    OverviewFalse,
+	})
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if len(resp.Routes) == 0 {
+		return 0, 0, false, nil
+	}
+
+	return resp.Routes[0].Duration, resp.Routes[0].Distance, true, nil
+}
+
+// RoutePair finds a route between two points with ETA, so unreachable matrix pairs
+// could be resolved with MatrixResponse.ResolveUnreachable.
+func (c *FastHttpDirections) RoutePair(ctx context.Context, from, to GeoPoint, profile DirectionsProfile,
+	opts ...CallOption) (duration, distance float64, found bool, err error) {
+	return c.ETA(ctx, from, to, profile, opts...)
+}
+
+// waypointValues validates per coordinate lists and encodes them semicolon separated.
+func (req *DirectionsRequest) waypointValues(values map[string]string) error {
+	n := len(req.Coordinates)
+	lists := []struct {
+		name  string
+		field string
+		len   int
+		item  func(i int) (string, error)
+	}{
+		{name: bearings, field: "Bearings", len: len(req.Bearings), item: func(i int) (string, error) {
+			b := req.Bearings[i]
+			if b == nil {
+				return "", nil
+			}
+			if b.Angle < 0 || b.Angle > 360 || b.Range < 0 || b.Range > 180 {
+				return "", validationErrorf(indexField("Bearings", i), ConstraintRange, "invalid bearing %d: %d,%d", i, b.Angle, b.Range)
+			}
+			return strconv.Itoa(b.Angle) + string(comma) + strconv.Itoa(b.Range), nil
+		}},
+		{name: radiuses, field: "Radiuses", len: len(req.Radiuses), item: func(i int) (string, error) {
+			r := req.Radiuses[i]
+			switch {
+			case math.IsInf(r, 1):
+				return "unlimited", nil
+			case r < 0 || math.IsNaN(r):
+				return "", validationErrorf(indexField("Radiuses", i), ConstraintRange, "invalid radius %d: %v", i, r)
+			case r == 0:
+				return "", nil
+			}
+			return strconv.FormatFloat(r, floatFormatNoExponent, -1, 64), nil
+		}},
+		{name: approaches, field: "Approaches", len: len(req.Approaches), item: func(i int) (string, error) {
+			return req.Approaches[i], nil
+		}},
+		{name: waypointNames, field: "WaypointNames", len: len(req.WaypointNames), item: func(i int) (string, error) {
+			return url.QueryEscape(req.WaypointNames[i]), nil
+		}},
+	}
+
+	for _, l := range lists {
+		if l.len == 0 {
+			continue
+		}
+		if l.len != n {
+			return validationErrorf(l.field, ConstraintItems, "%s must have %d items, one per coordinate, got %d", l.name, n, l.len)
+		}
+
+		items := make([]string, n)
+		for i := range items {
+			item, err := l.item(i)
+			if err != nil {
+				return err
+			}
+			items[i] = item
+		}
+		values[l.name] = strings.Join(items, ";")
+	}
+
+	return nil
+}
+
+// values validates params and encodes them, curves are semicolon separated x,y pairs.
+func (ev *EVParams) values(values map[string]string) error {
+	if ev.MaxCharge <= 0 {
+		return validationErrorf("EV.MaxCharge", ConstraintRange, "invalid electric vehicle max charge %d", ev.MaxCharge)
+	}
+	if ev.InitialCharge < 0 || ev.InitialCharge > ev.MaxCharge {
+		return validationErrorf("EV.InitialCharge", ConstraintRange,
+			"invalid electric vehicle charge %d of max %d", ev.InitialCharge, ev.MaxCharge)
+	}
+	if ev.MinChargeAtDestination < 0 {
+		return validationErrorf("EV.MinChargeAtDestination", ConstraintRange, "electric vehicle min charges must not be negative")
+	}
+	if ev.MinChargeAtChargingStation < 0 {
+		return validationErrorf("EV.MinChargeAtChargingStation", ConstraintRange,
+			"electric vehicle min charges must not be negative")
+	}
+
+	values[engine] = "electric"
+	values[evInitialCharge] = strconv.Itoa(ev.InitialCharge)
+	values[evMaxCharge] = strconv.Itoa(ev.MaxCharge)
+	if len(ev.ConnectorTypes) > 0 {
+		values[evConnectorTypes] = strings.Join(ev.ConnectorTypes, ",")
+	}
+	if len(ev.ChargingCurve) > 0 {
+		values[evChargingCurve] = formatCurve(ev.ChargingCurve)
+	}
+	if len(ev.ConsumptionCurve) > 0 {
+		values[evConsumptionCurve] = formatCurve(ev.ConsumptionCurve)
+	}
+	if ev.MinChargeAtDestination > 0 {
+		values[evMinChargeAtDest] = strconv.Itoa(ev.MinChargeAtDestination)
+	}
+	if ev.MinChargeAtChargingStation > 0 {
+		values[evMinChargeAtStop] = strconv.Itoa(ev.MinChargeAtChargingStation)
+	}
+
+	return nil
+}
+
+func formatCurve(curve []EVCurvePoint) string {
+	buf := bytes.Buffer{}
+	for i, p := range curve {
+		if i > 0 {
+			buf.WriteByte(';')
+		}
+		buf.WriteString(strconv.FormatFloat(p.X, floatFormatNoExponent, -1, 64))
+		buf.WriteByte(comma)
+		buf.WriteString(strconv.FormatFloat(p.Y, floatFormatNoExponent, -1, 64))
+	}
+	return buf.String()
+}
+
+// formatCoordinates formats points as semicolon separated lon,lat pairs.
+func formatCoordinates(points []GeoPoint) string {
+	buf := bytes.Buffer{}
+	for i, p := range points {
+		if i > 0 {
+			buf.WriteByte(';')
+		}
+		buf.WriteString(p.String())
+	}
+	return buf.String()
+}
+
+// NewFastHttpDirections creates fasthttp Directions client.
+func NewFastHttpDirections(opts ...Option) *FastHttpDirections {
+	c := FastHttpDirections{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.directionsAPIURL = c.endpointURL("/directions/v5/mapbox/")
+
+	return &c
+}
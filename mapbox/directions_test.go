@@ -0,0 +1,402 @@
+package mapbox
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+const testDirectionsRespBody = `{"routes":[{"weight_name":"auto","weight":310.5,"duration":290.1,"distance":1873.4,
+"legs":[{"summary":"Constitution Avenue","weight":310.5,"duration":290.1,"distance":1873.4,
+"steps":[{"name":"Constitution Avenue Northwest","mode":"driving","duration":100,"distance":600,
+"geometry":{"type":"LineString","coordinates":[[-77.0502,38.8892],[-77.0431,38.8921]]},
+"maneuver":{"type":"depart","instruction":"Drive east on Constitution Avenue Northwest.","location":[-77.0502,38.8892],"bearing_before":0,"bearing_after":78}}]}],
+"geometry":{"type":"LineString","coordinates":[[-77.0502,38.8892],[-77.0431,38.8921],[-77.0365,38.8977]]}}],
+"waypoints":[{"name":"Lincoln Memorial Circle Northwest","location":[-77.0502,38.8892],"distance":3.2},{"name":"","location":[-77.0365,38.8977],"distance":1.1}],
+"code":"Ok","uuid":"abc"}`
+
+func TestFastHttpDirections(t *testing.T) {
+	var uri string
+	d := NewFastHttpDirections(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(testDirectionsRespBody)
+			return nil
+		})))
+
+	resp, err := d.Directions(context.Background(), &DirectionsRequest{
+		Profile:     ProfileWalking,
+		Coordinates: []GeoPoint{{Lon: -77.0502, Lat: 38.8892}, {Lon: -77.0365, Lat: 38.8977}},
+		Geometries:  GeometriesGeoJSON,
+		Overview:    OverviewFull,
+		Steps:       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "/directions/v5/mapbox/walking/-77.050200,38.889200;-77.036500,38.897700?access_token=token&geometries=geojson&overview=full&steps=true"
+	if !strings.HasSuffix(uri, want) {
+		t.Errorf("uri = %s, want suffix %s", uri, want)
+	}
+
+	if resp.Meta.Endpoint != string(EndpointDirections) {
+		t.Errorf("Meta.Endpoint = %s", resp.Meta.Endpoint)
+	}
+	if resp.Code != DirectionsCodeOk || len(resp.Routes) != 1 || len(resp.Waypoints) != 2 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	route := resp.Routes[0]
+	switch {
+	case route.Duration != 290.1 || route.Distance != 1873.4 || len(route.Legs) != 1:
+		t.Errorf("unexpected route %+v", route)
+	case len(route.Geometry.LineString()) != 3 || route.Geometry.Type != "LineString":
+		t.Errorf("unexpected geometry %+v", route.Geometry)
+	case len(route.Legs[0].Steps) != 1 || route.Legs[0].Steps[0].Maneuver.BearingAfter != 78:
+		t.Errorf("unexpected steps %+v", route.Legs[0].Steps)
+	case resp.Waypoints[1].GeoPoint() != (GeoPoint{Lon: -77.0365, Lat: 38.8977}):
+		t.Errorf("unexpected waypoints %+v", resp.Waypoints)
+	}
+
+	if _, err := d.Directions(context.Background(), &DirectionsRequest{Coordinates: []GeoPoint{{Lon: 1, Lat: 1}}}); err == nil {
+		t.Error("coordinates error expected")
+	}
+}
+
+func TestRouteGeometry_Polyline(t *testing.T) {
+	g := RouteGeometry{}
+	if err := g.UnmarshalJSON([]byte(`"_p~iF~ps|U_ulLnnqC"`)); err != nil {
+		t.Fatal(err)
+	}
+	if g.Polyline != "_p~iF~ps|U_ulLnnqC" || g.Type != "" {
+		t.Errorf("unexpected geometry %+v", g)
+	}
+
+	b, err := g.MarshalJSON()
+	if err != nil || string(b) != `"_p~iF~ps|U_ulLnnqC"` {
+		t.Errorf("MarshalJSON() = %s, %v", b, err)
+	}
+
+	if line := g.LineString(); len(line) != 2 || line[1] != (GeoPoint{Lon: -120.95, Lat: 40.7}) {
+		t.Errorf("LineString() = %v", line)
+	}
+}
+
+func TestDirectionsPresets(t *testing.T) {
+	var uri string
+	d := NewFastHttpDirections(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(testDirectionsRespBody)
+			return nil
+		})))
+	from, to := GeoPoint{Lon: -77.0502, Lat: 38.8892}, GeoPoint{Lon: -77.0365, Lat: 38.8977}
+
+	tests := []struct {
+		name string
+		req  *DirectionsRequest
+		want string
+	}{
+		{name: "car navigation", req: PresetCarNavigation(from, to), want: "/driving-traffic/-77.050200,38.889200;-77.036500,38.897700?" +
+			"access_token=token&annotations=congestion,duration,distance&banner_instructions=true&geometries=polyline6" +
+			"&overview=full&steps=true&voice_instructions=true"},
+		{name: "delivery truck economy", req: PresetDeliveryTruckEconomy(from, to), want: "/driving/-77.050200,38.889200;-77.036500,38.897700?" +
+			"access_token=token&annotations=duration,distance&geometries=polyline6&overview=full&steps=true"},
+		{name: "pedestrian", req: PresetPedestrian(from, to), want: "/walking/-77.050200,38.889200;-77.036500,38.897700?" +
+			"access_token=token&banner_instructions=true&geometries=polyline6&overview=simplified&steps=true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := d.Directions(context.Background(), tt.req); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasSuffix(uri, tt.want) {
+				t.Errorf("uri = %s, want suffix %s", uri, tt.want)
+			}
+		})
+	}
+}
+
+func TestFastHttpDirections_RoutePair(t *testing.T) {
+	body := `{"code":"NoRoute","message":"No route found","routes":[]}`
+	var uri string
+	d := NewFastHttpDirections(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(body)
+			return nil
+		})))
+
+	var router PairRouter = d
+	if _, _, found, err := router.RoutePair(context.Background(), GeoPoint{Lon: 1, Lat: 1}, GeoPoint{Lon: 2, Lat: 2}, ProfileDriving); err != nil || found {
+		t.Errorf("RoutePair() found = %v, err = %v, want no route", found, err)
+	}
+
+	body = testDirectionsRespBody
+	duration, distance, found, err := router.RoutePair(context.Background(), GeoPoint{Lon: 1, Lat: 1}, GeoPoint{Lon: 2, Lat: 2}, ProfileWalking)
+	if err != nil || !found || duration != 290.1 || distance != 1873.4 {
+		t.Errorf("RoutePair() = %v, %v, %v, %v", duration, distance, found, err)
+	}
+	if !strings.Contains(uri, "/directions/v5/mapbox/walking/") {
+		t.Errorf("uri = %s, want walking profile", uri)
+	}
+}
+
+func TestFastHttpDirections_ETA(t *testing.T) {
+	var uri string
+	d := NewFastHttpDirections(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(testDirectionsRespBody)
+			return nil
+		})))
+
+	duration, distance, found, err := d.ETA(context.Background(), GeoPoint{Lon: 1, Lat: 1}, GeoPoint{Lon: 2, Lat: 2}, ProfileCycling)
+	if err != nil || !found || duration != 290.1 || distance != 1873.4 {
+		t.Errorf("ETA() = %v, %v, %v, %v", duration, distance, found, err)
+	}
+	want := "/cycling/1.000000,1.000000;2.000000,2.000000?access_token=token&overview=false"
+	if !strings.HasSuffix(uri, want) {
+		t.Errorf("uri = %s, want suffix %s", uri, want)
+	}
+}
+
+func TestFastHttpDirections_Annotations(t *testing.T) {
+	var uri string
+	d := NewFastHttpDirections(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(`{"code":"Ok","routes":[{"duration":20,"distance":200,"geometry":"_p~iF~ps|U_ulLnnqC",
+"legs":[{"duration":20,"distance":200,"annotation":{"distance":[120,80],"duration":[12,8],"speed":[10,10],
+"congestion":["low","heavy"],"maxspeed":[{"speed":50,"unit":"km/h"},{"unknown":true}]}}]}]}`)
+			return nil
+		})))
+
+	resp, err := d.Directions(context.Background(), &DirectionsRequest{
+		Coordinates: []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}},
+		Overview:    OverviewFull,
+		Annotations: []string{AnnotationCongestion, AnnotationMaxSpeed},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "&annotations=congestion,maxspeed&overview=full"; !strings.HasSuffix(uri, want) {
+		t.Errorf("uri = %s, want suffix %s", uri, want)
+	}
+
+	a := resp.Routes[0].Legs[0].Annotation
+	switch {
+	case a == nil:
+		t.Fatal("annotation expected")
+	case len(a.Distance) != 2 || a.Duration[1] != 8 || a.Speed[0] != 10:
+		t.Errorf("unexpected annotation %+v", a)
+	case len(a.Congestion) != 2 || a.Congestion[1] != CongestionHeavy:
+		t.Errorf("unexpected congestion %+v", a.Congestion)
+	case a.MaxSpeed[0] != (MaxSpeed{Speed: 50, Unit: "km/h"}) || !a.MaxSpeed[1].Unknown:
+		t.Errorf("unexpected max speed %+v", a.MaxSpeed)
+	}
+}
+
+func TestMaxSpeed_In(t *testing.T) {
+	tests := []struct {
+		name   string
+		speed  MaxSpeed
+		unit   string
+		want   float64
+		wantOk bool
+	}{
+		{"same unit", MaxSpeed{Speed: 50, Unit: SpeedUnitKMH}, SpeedUnitKMH, 50, true},
+		{"mph to km/h", MaxSpeed{Speed: 30, Unit: SpeedUnitMPH}, SpeedUnitKMH, 48.28032, true},
+		{"km/h to mph", MaxSpeed{Speed: 80.4672, Unit: SpeedUnitKMH}, SpeedUnitMPH, 50, true},
+		{"unlimited", MaxSpeed{None: true}, SpeedUnitMPH, math.Inf(1), true},
+		{"unknown", MaxSpeed{Unknown: true}, SpeedUnitKMH, 0, false},
+		{"unknown unit", MaxSpeed{Speed: 50, Unit: "knots"}, SpeedUnitKMH, 0, false},
+		{"unknown target unit", MaxSpeed{Speed: 50, Unit: SpeedUnitKMH}, "m/s", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.speed.In(tt.unit)
+			if ok != tt.wantOk || got != tt.want && math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("In() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	if kmh, ok := (MaxSpeed{None: true}).KMH(); !ok || !math.IsInf(kmh, 1) {
+		t.Errorf("KMH() = %v, %v, want +Inf, true", kmh, ok)
+	}
+	if mph, ok := (MaxSpeed{Speed: 100, Unit: SpeedUnitKMH}).MPH(); !ok || math.Abs(mph-62.137119) > 1e-6 {
+		t.Errorf("MPH() = %v, %v", mph, ok)
+	}
+}
+
+func TestDirectionsRequest_WaypointValues(t *testing.T) {
+	coordinates := []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}, {Lon: 3, Lat: 3}}
+	tests := []struct {
+		name    string
+		req     DirectionsRequest
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "empty", req: DirectionsRequest{Coordinates: coordinates}, want: map[string]string{}},
+		{
+			name: "all",
+			req: DirectionsRequest{
+				Coordinates:   coordinates,
+				Bearings:      []*Bearing{{Angle: 45, Range: 90}, nil, {Angle: 0, Range: 180}},
+				Radiuses:      []float64{50, 0, math.Inf(1)},
+				Approaches:    []string{"", ApproachCurb, ApproachUnrestricted},
+				WaypointNames: []string{"Home", "", "Work; 2nd floor"},
+			},
+			want: map[string]string{
+				"bearings":       "45,90;;0,180",
+				"radiuses":       "50;;unlimited",
+				"approaches":     ";curb;unrestricted",
+				"waypoint_names": "Home;;Work%3B+2nd+floor",
+			},
+		},
+		{name: "length mismatch", req: DirectionsRequest{Coordinates: coordinates, Approaches: []string{ApproachCurb}}, wantErr: true},
+		{name: "invalid bearing", req: DirectionsRequest{Coordinates: coordinates, Bearings: []*Bearing{nil, {Angle: 400}, nil}}, wantErr: true},
+		{name: "invalid radius", req: DirectionsRequest{Coordinates: coordinates, Radiuses: []float64{1, -1, 1}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]string{}
+			err := tt.req.waypointValues(values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("values = %v, want %v", values, tt.want)
+			}
+		})
+	}
+}
+
+func TestFastHttpDirections_Instructions(t *testing.T) {
+	var uri string
+	d := NewFastHttpDirections(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(`{"code":"Ok","routes":[{"duration":20,"distance":200,"geometry":"_p~iF~ps|U_ulLnnqC",
+"legs":[{"duration":20,"distance":200,"steps":[{"name":"Main Street","geometry":"_p~iF~ps|U",
+"maneuver":{"type":"turn","modifier":"left","instruction":"Turn left onto Main Street."},
+"voiceInstructions":[{"distanceAlongGeometry":150,"announcement":"In 500 feet, turn left onto Main Street.",
+"ssmlAnnouncement":"<speak>In 500 feet, turn left onto Main Street.</speak>"}],
+"bannerInstructions":[{"distanceAlongGeometry":150,"primary":{"text":"Main Street","type":"turn","modifier":"left",
+"components":[{"text":"Main Street","type":"text","abbr":"Main St","abbr_priority":0}]},
+"sub":{"text":"","components":[{"text":"","type":"lane","directions":["left"],"active":true}]}}]}]}]}]}`)
+			return nil
+		})))
+
+	req := &DirectionsRequest{
+		Coordinates:        []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}},
+		VoiceInstructions:  true,
+		BannerInstructions: true,
+		VoiceUnits:         VoiceUnitsMetric,
+		Language:           "en",
+	}
+	if _, err := d.Directions(context.Background(), req); err == nil {
+		t.Error("steps required error expected")
+	}
+
+	req.Steps = true
+	resp, err := d.Directions(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "?access_token=token&banner_instructions=true&language=en&steps=true&voice_instructions=true&voice_units=metric"
+	if !strings.HasSuffix(uri, want) {
+		t.Errorf("uri = %s, want suffix %s", uri, want)
+	}
+
+	step := resp.Routes[0].Legs[0].Steps[0]
+	if len(step.VoiceInstructions) != 1 || step.VoiceInstructions[0].DistanceAlongGeometry != 150 ||
+		!strings.HasPrefix(step.VoiceInstructions[0].SSMLAnnouncement, "<speak>") {
+		t.Errorf("unexpected voice instructions %+v", step.VoiceInstructions)
+	}
+	if len(step.BannerInstructions) != 1 {
+		t.Fatalf("unexpected banner instructions %+v", step.BannerInstructions)
+	}
+	banner := step.BannerInstructions[0]
+	switch {
+	case banner.Primary.Modifier != "left" || banner.Primary.Components[0].Abbreviation != "Main St":
+		t.Errorf("unexpected primary %+v", banner.Primary)
+	case banner.Secondary != nil:
+		t.Errorf("unexpected secondary %+v", banner.Secondary)
+	case banner.Sub == nil || !banner.Sub.Components[0].Active || banner.Sub.Components[0].Directions[0] != "left":
+		t.Errorf("unexpected sub %+v", banner.Sub)
+	}
+}
+
+func TestFastHttpDirections_EV(t *testing.T) {
+	var uri string
+	d := NewFastHttpDirections(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(`{"code":"Ok","routes":[],"waypoints":[
+{"name":"A","location":[13.38,52.51],"distance":1},
+{"name":"B","location":[12.1,51.3],"distance":0,"metadata":{"type":"charging-station","name":"Ionity Leipzig",
+"station_id":"st-1","charge_time":1200,"charge_at_arrival":8000,"charge_to":60000,"plug_type":"ccs_combo_type2","power_kw":150}},
+{"name":"C","location":[11.57,48.13],"distance":2}]}`)
+			return nil
+		})))
+
+	ev := &EVParams{
+		InitialCharge:    60000,
+		MaxCharge:        80000,
+		ConnectorTypes:   []string{ConnectorCCSCombo2, ConnectorTesla},
+		ChargingCurve:    []EVCurvePoint{{X: 0, Y: 100000}, {X: 40000, Y: 70000}},
+		ConsumptionCurve: []EVCurvePoint{{X: 32, Y: 150}, {X: 80, Y: 180.5}},
+	}
+	coords := []GeoPoint{{Lon: 13.38, Lat: 52.51}, {Lon: 11.57, Lat: 48.13}}
+
+	resp, err := d.Directions(context.Background(), &DirectionsRequest{
+		Profile: ProfileDrivingTraffic, Coordinates: coords, EV: ev})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"engine=electric", "ev_initial_charge=60000", "ev_max_charge=80000",
+		"ev_connector_types=ccs_combo_type2,tesla", "ev_charging_curve=0,100000;40000,70000",
+		"energy_consumption_curve=32,150;80,180.5"} {
+		if !strings.Contains(uri, want) {
+			t.Errorf("uri %s doesn't contain %s", uri, want)
+		}
+	}
+
+	stations := resp.ChargingStations()
+	want := WaypointMetadata{Type: WaypointChargingStation, Name: "Ionity Leipzig", StationID: "st-1", ChargeTime: 1200,
+		ChargeAtArrival: 8000, ChargeTo: 60000, PlugType: ConnectorCCSCombo2, PowerKW: 150}
+	if len(stations) != 1 || !reflect.DeepEqual(*stations[0].Metadata, want) {
+		t.Errorf("ChargingStations() = %+v", stations)
+	}
+
+	tests := []struct {
+		name    string
+		profile DirectionsProfile
+		ev      EVParams
+	}{
+		{name: "driving profile", profile: ProfileDriving, ev: *ev},
+		{name: "initial above max", profile: ProfileDrivingTraffic, ev: EVParams{InitialCharge: 2, MaxCharge: 1}},
+		{name: "no max", profile: ProfileDrivingTraffic, ev: EVParams{InitialCharge: 1}},
+		{name: "negative min charge", profile: ProfileDrivingTraffic,
+			ev: EVParams{InitialCharge: 1, MaxCharge: 2, MinChargeAtDestination: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev := tt.ev
+			if _, err := d.Directions(context.Background(), &DirectionsRequest{
+				Profile: tt.profile, Coordinates: coords, EV: &ev}); err == nil {
+				t.Error("error expected")
+			}
+		})
+	}
+}
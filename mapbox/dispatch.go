@@ -0,0 +1,108 @@
+package mapbox
+
+import (
+	"strconv"
+	"time"
+)
+
+// DispatchPlan is an optimization solution as ordered stops of every vehicle.
+type DispatchPlan struct {
+	Vehicles []VehiclePlan
+}
+
+// VehiclePlan is a single vehicle schedule.
+type VehiclePlan struct {
+	// Vehicle is a routing problem vehicle name or an optimized trip index.
+	Vehicle string
+	// Stops are in visiting order.
+	Stops []DispatchStop
+}
+
+// DispatchStop is a vehicle stop.
+type DispatchStop struct {
+	// Name is a routing problem location name or a street name an optimized trip waypoint snapped to.
+	Name     string
+	Location GeoPoint
+	// Waypoint is an input coordinate index of optimized trips, it is -1 for routing solutions.
+	Waypoint int
+	// Type is a routing solution stop type, e.g. StopService, it is empty for optimized trips.
+	Type string
+	// Arrival is an estimated arrival time.
+	Arrival time.Time
+	// Leg is the way from the previous stop, it is nil for the first stop and for routing solutions,
+	// mapbox returns no geometries for them.
+	Leg LineString
+	// Services, Pickups and Dropoffs are names of routing problem jobs done at the stop.
+	Services []string
+	Pickups  []string
+	Dropoffs []string
+}
+
+// DispatchPlan converts optimized trips starting at departure to stops of a vehicle per trip.
+// Roundtrips end with the first stop again. Arrivals add up legs durations, waiting and service times are not known.
+// Legs are as detailed as requested overview, or steps if they were requested, see Route.LegGeometry.
+func (r *OptimizationResponse) DispatchPlan(departure time.Time) *DispatchPlan {
+	plan := &DispatchPlan{Vehicles: make([]VehiclePlan, len(r.Trips))}
+	for t := range plan.Vehicles {
+		plan.Vehicles[t].Vehicle = strconv.Itoa(t)
+	}
+
+	for _, i := range r.Order() {
+		w := r.Waypoints[i]
+		if w.TripsIndex < 0 || w.TripsIndex >= len(plan.Vehicles) {
+			continue
+		}
+		v := &plan.Vehicles[w.TripsIndex]
+		v.Stops = append(v.Stops, DispatchStop{Name: w.Name, Location: w.GeoPoint(), Waypoint: i})
+	}
+
+	for t := range plan.Vehicles {
+		trip := &r.Trips[t]
+		stops := plan.Vehicles[t].Stops
+		if len(stops) > 0 && len(trip.Legs) == len(stops) {
+			stops = append(stops, stops[0])
+		}
+
+		arrival := departure
+		for k := range stops {
+			if k > 0 && k <= len(trip.Legs) {
+				arrival = arrival.Add(time.Duration(trip.Legs[k-1].Duration * float64(time.Second)))
+				stops[k].Leg = trip.LegGeometry(k - 1)
+			}
+			stops[k].Arrival = arrival
+		}
+		plan.Vehicles[t].Stops = stops
+	}
+
+	return plan
+}
+
+// DispatchPlan converts solution of problem to stops of every vehicle, stop locations are looked up in problem.
+func (s *RoutingSolution) DispatchPlan(problem *RoutingProblem) *DispatchPlan {
+	locations := make(map[string]GeoPoint, len(problem.Locations))
+	for _, l := range problem.Locations {
+		if len(l.Coordinates) >= 2 {
+			locations[l.Name] = GeoPoint{Lon: l.Coordinates[0], Lat: l.Coordinates[1]}
+		}
+	}
+
+	plan := &DispatchPlan{Vehicles: make([]VehiclePlan, len(s.Routes))}
+	for i, r := range s.Routes {
+		stops := make([]DispatchStop, len(r.Stops))
+		for j, st := range r.Stops {
+			stops[j] = DispatchStop{
+				Name:     st.Location,
+				Location: locations[st.Location],
+				Waypoint: -1,
+				Type:     st.Type,
+				Arrival:  st.ETA,
+				Services: st.Services,
+				Pickups:  st.Pickups,
+				Dropoffs: st.Dropoffs,
+			}
+		}
+		plan.Vehicles[i] = VehiclePlan{Vehicle: r.Vehicle, Stops: stops}
+	}
+
+	return plan
+}
@@ -0,0 +1,63 @@
+package mapbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOptimizationResponse_DispatchPlan(t *testing.T) {
+	trip := lineRoute(0, 2000, []float64{0, 0}, []float64{0.02, 0}, []float64{0.01, 0})
+	trip.Legs = []RouteLeg{{Duration: 60, Distance: 1000}, {Duration: 30, Distance: 500}, {Duration: 90, Distance: 500}}
+	resp := OptimizationResponse{
+		Trips: []Route{trip},
+		// input coordinates 0, 2, 1 are visited in 0, 1, 2 order
+		Waypoints: []OptimizedWaypoint{
+			{Name: "a", Location: []float64{0, 0}, WaypointIndex: 0},
+			{Name: "c", Location: []float64{0.01, 0}, WaypointIndex: 2},
+			{Name: "b", Location: []float64{0.02, 0}, WaypointIndex: 1},
+		},
+	}
+
+	departure := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	plan := resp.DispatchPlan(departure)
+	if len(plan.Vehicles) != 1 || plan.Vehicles[0].Vehicle != "0" {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+
+	stops := plan.Vehicles[0].Stops
+	wantNames := []string{"a", "b", "c", "a"}
+	wantArrivals := []time.Duration{0, time.Minute, 90 * time.Second, 3 * time.Minute}
+	if len(stops) != len(wantNames) {
+		t.Fatalf("unexpected stops %+v", stops)
+	}
+	for i, s := range stops {
+		if s.Name != wantNames[i] || !s.Arrival.Equal(departure.Add(wantArrivals[i])) {
+			t.Errorf("stop %d = %s at %v, want %s at %v", i, s.Name, s.Arrival, wantNames[i], departure.Add(wantArrivals[i]))
+		}
+	}
+	if stops[0].Leg != nil || len(stops[1].Leg) < 2 || stops[1].Waypoint != 2 {
+		t.Errorf("unexpected legs %+v", stops)
+	}
+}
+
+func TestRoutingSolution_DispatchPlan(t *testing.T) {
+	eta := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	problem := &RoutingProblem{Locations: []ProblemLocation{
+		{Name: "depot", Coordinates: []float64{1, 2}},
+		{Name: "shop", Coordinates: []float64{3, 4}},
+	}}
+	solution := RoutingSolution{Routes: []SolutionRoute{{Vehicle: "van", Stops: []SolutionStop{
+		{Type: StopStart, Location: "depot", ETA: eta},
+		{Type: StopService, Location: "shop", ETA: eta.Add(time.Hour), Services: []string{"delivery"}},
+	}}}}
+
+	plan := solution.DispatchPlan(problem)
+	if len(plan.Vehicles) != 1 || plan.Vehicles[0].Vehicle != "van" || len(plan.Vehicles[0].Stops) != 2 {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	s := plan.Vehicles[0].Stops[1]
+	if s.Location != (GeoPoint{Lon: 3, Lat: 4}) || s.Type != StopService || !s.Arrival.Equal(eta.Add(time.Hour)) ||
+		len(s.Services) != 1 || s.Waypoint != -1 {
+		t.Errorf("unexpected stop %+v", s)
+	}
+}
@@ -0,0 +1,170 @@
+package mapbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// EndpointURL builds request URIs of a mapbox API path, e.g. /styles/v1/.
+// It is shared by SDK services and exported to implement clients of endpoints SDK doesn't cover yet, see Base.
+type EndpointURL struct {
+	prefix              []byte
+	accessTokenGetValue []byte
+}
+
+// endpointURL joins root api and path parts.
+func (c *config) endpointURL(path ...string) EndpointURL {
+	return EndpointURL{
+		prefix:              c.apiURL(path...),
+		accessTokenGetValue: c.accessTokenGetValue,
+	}
+}
+
+// Write writes root api, path, segments, access token and params sorted by key to buf.
+// Segments are written as is, user input must be escaped, e.g. with url.PathEscape.
+func (e EndpointURL) Write(buf *bytes.Buffer, params map[string]string, segments ...string) {
+	buf.Write(e.prefix)
+	for _, s := range segments {
+		buf.WriteString(s)
+	}
+	buf.Write(e.accessTokenGetValue)
+
+	encodeValues(buf, params)
+}
+
+// Base is a transport SDK services are built on: options, pooled buffers, logging, access log,
+// failover, Close and retries of rate limited calls.
+// Embed it to implement clients of endpoints SDK doesn't cover yet.
+type Base struct {
+	config
+
+	stringBufPull *stringsBufferPool
+}
+
+// BaseRequest describes a call made with Base.
+type BaseRequest struct {
+	// Op names the call in logs and access log, e.g. isochrone.
+	Op string
+	// Method default to GET.
+	Method string
+	// Endpoint is built with Base.EndpointURL once and reused.
+	Endpoint EndpointURL
+	// Segments follow Endpoint path, see EndpointURL.Write.
+	Segments []string
+	Params   map[string]string
+	// Body is sent as JSON if set.
+	Body []byte
+	// MaxRetries of calls rejected with 429 Too Many Requests, Backoff is the first retry delay doubled each retry.
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+// BaseResponse is a raw mapbox API response.
+type BaseResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta       Meta
+	StatusCode int
+	Body       []byte
+	// Decoded is set by Invoke if CustomDecoder is registered for the call Op
+	Decoded interface{}
+}
+
+// Invoker executes mapbox API calls, so plugins could add endpoints SDK doesn't cover yet
+// sharing pooled buffers, token injection, retries, hooks and decoding with SDK services.
+type Invoker interface {
+	// EndpointURL returns request URIs builder of root api and path.
+	EndpointURL(path ...string) EndpointURL
+	// Do calls mapbox API returning non 2xx responses without error.
+	Do(ctx context.Context, req *BaseRequest, opts ...CallOption) (*BaseResponse, error)
+	// Invoke calls mapbox API and decodes successful response into out.
+	Invoke(ctx context.Context, req *BaseRequest, out json.Unmarshaler, opts ...CallOption) (*BaseResponse, error)
+}
+
+var _ Invoker = (*Base)(nil)
+
+// NewInvoker creates Invoker configured with the same options as SDK services.
+func NewInvoker(opts ...Option) Invoker {
+	return NewBase(opts...)
+}
+
+// EndpointURL returns request URIs builder of root api and path, e.g. EndpointURL("/isochrone/v1/mapbox/").
+func (b *Base) EndpointURL(path ...string) EndpointURL {
+	return b.endpointURL(path...)
+}
+
+// Do calls mapbox API, non 2xx responses are returned without error, so callers check StatusCode.
+func (b *Base) Do(ctx context.Context, req *BaseRequest, opts ...CallOption) (*BaseResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	method := getMethod
+	if req.Method != "" {
+		method = []byte(req.Method)
+	}
+
+	buf := b.stringBufPull.acquireStringsBuilder()
+	defer b.stringBufPull.releaseStringsBuilder(buf)
+
+	req.Endpoint.Write(buf, req.Params, req.Segments...)
+
+	reqURI := buf.Bytes()
+
+	b.logRequest(ctx, req.Op, reqURI, req.Params)
+
+	resp, err := b.retryRateLimited(ctx, req.MaxRetries, req.Backoff, func() (*rawResponse, error) {
+		return b.do(ctx, req.Op, method, reqURI, req.Body)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b.logResponse(ctx, req.Op, resp.statusCode, resp.meta.RequestID, resp.body)
+
+	return &BaseResponse{
+		RateLimit:  resp.rateLimit,
+		Meta:       resp.meta,
+		StatusCode: resp.statusCode,
+		Body:       resp.body,
+	}, nil
+}
+
+// Invoke calls mapbox API, non 2xx responses are returned as errors.
+// Response is decoded with CustomDecoder registered for Endpoint(req.Op) into BaseResponse.Decoded if any,
+// with out otherwise, out could be nil to skip decoding.
+func (b *Base) Invoke(ctx context.Context, req *BaseRequest, out json.Unmarshaler, opts ...CallOption) (*BaseResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	resp, err := b.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, newStatusError(req.Op, "", resp.StatusCode, resp.Meta.RequestID, resp.Body)
+	}
+
+	if decoded, ok, err := b.customDecode(Endpoint(req.Op), resp.Body); ok {
+		if err != nil {
+			return nil, err
+		}
+		resp.Decoded = decoded
+		return resp, nil
+	}
+
+	if out != nil {
+		if err := out.UnmarshalJSON(resp.Body); err != nil {
+			return nil, errorf("failed to unmarshall %s resp %s: %w", req.Op, string(resp.Body), err)
+		}
+	}
+
+	return resp, nil
+}
+
+func NewBase(opts ...Option) *Base {
+	return &Base{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+}
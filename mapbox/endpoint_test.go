@@ -0,0 +1,95 @@
+package mapbox
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// isochrone is a client of an endpoint SDK doesn't cover, built on Base.
+type isochrone struct {
+	*Base
+
+	isochroneURL EndpointURL
+}
+
+func TestBase_Do(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+
+	var uris []string
+	b := NewBase(AccessToken("token"), WithClock(clock), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uris = append(uris, string(req.Header.Method())+" "+string(req.RequestURI()))
+			if len(uris) == 1 {
+				resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+				return nil
+			}
+			resp.SetBodyString(`{"features":[]}`)
+			return nil
+		})))
+	c := isochrone{Base: b, isochroneURL: b.EndpointURL("/isochrone/v1/mapbox/")}
+
+	resp, err := c.Do(context.Background(), &BaseRequest{
+		Op:         "isochrone",
+		Endpoint:   c.isochroneURL,
+		Segments:   []string{"driving/", "-77.05,38.889"},
+		Params:     map[string]string{"contours_minutes": "10", "polygons": trueStr},
+		MaxRetries: 1,
+		Backoff:    time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK || string(resp.Body) != `{"features":[]}` || resp.Meta.Attempts != 2 {
+		t.Errorf("unexpected resp %+v", resp)
+	}
+
+	want := "GET https://api.mapbox.com/isochrone/v1/mapbox/driving/-77.05,38.889?access_token=token&contours_minutes=10&polygons=true"
+	if len(uris) != 2 || uris[0] != want || uris[1] != want {
+		t.Errorf("uris = %v, want %s", uris, want)
+	}
+}
+
+func TestInvoker_Invoke(t *testing.T) {
+	client := HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		if strings.Contains(string(req.RequestURI()), "missing") {
+			resp.SetStatusCode(fasthttp.StatusNotFound)
+			resp.SetBodyString(`{"message":"Not Found"}`)
+			return nil
+		}
+		resp.SetBodyString(`{"tilejson":"2.2.0","id":"mapbox.mapbox-streets-v8"}`)
+		return nil
+	}))
+
+	inv := NewInvoker(client)
+	tilesURL := inv.EndpointURL("/v4/")
+
+	tj := TileJSON{}
+	if _, err := inv.Invoke(context.Background(), &BaseRequest{Op: "tilejson", Endpoint: tilesURL, Segments: []string{"mapbox.mapbox-streets-v8.json"}}, &tj); err != nil {
+		t.Fatal(err)
+	}
+	if tj.ID != "mapbox.mapbox-streets-v8" {
+		t.Errorf("unexpected tilejson %+v", tj)
+	}
+
+	_, err := inv.Invoke(context.Background(), &BaseRequest{Op: "tilejson", Endpoint: tilesURL, Segments: []string{"missing.json"}}, &tj)
+	if err == nil || err.Error() != `failed to tilejson statusCode 404 request id  resp {"message":"Not Found"}` {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	inv = NewInvoker(client, CustomDecoder(EndpointTileJSON, func(body []byte) (interface{}, error) {
+		return len(body), nil
+	}))
+	resp, err := inv.Invoke(context.Background(), &BaseRequest{Op: string(EndpointTileJSON), Endpoint: tilesURL}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Decoded != len(resp.Body) {
+		t.Errorf("unexpected decoded %v", resp.Decoded)
+	}
+}
@@ -0,0 +1,105 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+)
+
+// Enrichment holds data derived for a reverse geocoded coordinate.
+type Enrichment struct {
+	// CountryCode is an upper-cased ISO 3166 alpha 2 country code.
+	CountryCode string
+	// Timezone is an IANA timezone name, e.g. Europe/Berlin.
+	// Empty if TimezoneLookup option is not set.
+	Timezone string
+}
+
+// TimezoneResolver resolves IANA timezone of a coordinate,
+// e.g. with an embedded timezone boundaries index or tilequery API.
+type TimezoneResolver interface {
+	Timezone(ctx context.Context, point GeoPoint) (string, error)
+}
+
+const defaultTimezoneProperty = "tzid"
+
+// TilequeryTimezoneResolver resolves timezone with tilequery API over a tileset of timezone boundary polygons,
+// e.g. timezone-boundary-builder data uploaded to the account.
+type TilequeryTimezoneResolver struct {
+	Tilequery Tilequery
+	// TilesetID of the timezone boundaries, required.
+	TilesetID string
+	// Layer of the tileset with the polygons, all layers are queried if empty.
+	Layer string
+	// Property holding IANA timezone name, default tzid.
+	Property string
+}
+
+// Timezone returns timezone of the polygon containing point, empty if there is none, e.g. in the open sea.
+func (r TilequeryTimezoneResolver) Timezone(ctx context.Context, point GeoPoint) (string, error) {
+	if r.TilesetID == "" {
+		return "", validationErrorf("TilesetID", ConstraintRequired, "timezone tileset id is required")
+	}
+
+	req := &TilequeryRequest{
+		TilesetIDs: []string{r.TilesetID},
+		GeoPoint:   point,
+		Limit:      1,
+		Geometry:   TilequeryGeometryPolygon,
+	}
+	if r.Layer != "" {
+		req.Layers = []string{r.Layer}
+	}
+
+	resp, err := r.Tilequery.Tilequery(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Features) == 0 {
+		return "", nil
+	}
+
+	property := r.Property
+	if property == "" {
+		property = defaultTimezoneProperty
+	}
+
+	tz, ok := resp.Features[0].Properties[property].(string)
+	if !ok {
+		return "", errorf("timezone property %q is not a string in tileset %s", property, r.TilesetID)
+	}
+
+	return tz, nil
+}
+
+// TimezoneLookup sets resolver used to enrich reverse geocode results with timezone.
+func TimezoneLookup(r TimezoneResolver) Option {
+	return func(c config) config {
+		c.timezoneResolver = r
+		return c
+	}
+}
+
+func (c *config) enrich(ctx context.Context, point GeoPoint, features []Feature) (*Enrichment, error) {
+	e := &Enrichment{CountryCode: countryCode(features)}
+
+	if c.timezoneResolver != nil {
+		tz, err := c.timezoneResolver.Timezone(ctx, point)
+		if err != nil {
+			return nil, errorf("failed to resolve timezone of %f,%f: %w", point.Lon, point.Lat, err)
+		}
+		e.Timezone = tz
+	}
+
+	return e, nil
+}
+
+// countryCode looks for country short code in features and their context.
+func countryCode(features []Feature) string {
+	for i := range features {
+		if c, ok := features[i].Country(); ok && c.ShortCode != "" {
+			return strings.ToUpper(c.ShortCode)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,77 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+type timezoneResolverFunc func(ctx context.Context, point GeoPoint) (string, error)
+
+func (f timezoneResolverFunc) Timezone(ctx context.Context, point GeoPoint) (string, error) {
+	return f(ctx, point)
+}
+
+func TestFastHttpGeocoder_ReverseGeocode_Enrich(t *testing.T) {
+	g := NewFastHttpGeocoder(
+		HttpClient(&fastHttpClient{}),
+		TimezoneLookup(timezoneResolverFunc(func(_ context.Context, point GeoPoint) (string, error) {
+			if point.Lon != -77.05 {
+				t.Errorf("unexpected point %v", point)
+			}
+			return "America/New_York", nil
+		})),
+	)
+
+	resp, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{
+		GeoPoint: GeoPoint{Lon: -77.05, Lat: 38.889},
+		Enrich:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Enrichment{CountryCode: "US", Timezone: "America/New_York"}
+	if resp.Enrichment == nil || *resp.Enrichment != want {
+		t.Errorf("unexpected enrichment %+v", resp.Enrichment)
+	}
+}
+
+func TestTilequeryTimezoneResolver_Timezone(t *testing.T) {
+	var uri string
+	body := `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[13.4,52.5]},` +
+		`"properties":{"tzid":"Europe/Berlin","tilequery":{"distance":0,"geometry":"polygon","layer":"timezones"}}}]}`
+	r := TilequeryTimezoneResolver{
+		Tilequery: NewFastHttpTilequery(AccessToken("token"), HttpClient(fastHttpClientFunc(
+			func(req *fasthttp.Request, resp *fasthttp.Response) error {
+				uri = string(req.RequestURI())
+				resp.SetBodyString(body)
+				return nil
+			}))),
+		TilesetID: "user.timezones",
+		Layer:     "timezones",
+	}
+
+	tz, err := r.Timezone(context.Background(), GeoPoint{Lon: 13.4, Lat: 52.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tz != "Europe/Berlin" {
+		t.Errorf("Timezone() = %s", tz)
+	}
+	if !strings.Contains(uri, "/v4/user.timezones/tilequery/") || !strings.Contains(uri, "&layers=timezones&limit=1") {
+		t.Errorf("unexpected uri %s", uri)
+	}
+
+	body = `{"type":"FeatureCollection","features":[]}`
+	if tz, err := r.Timezone(context.Background(), GeoPoint{Lon: -30, Lat: 40}); err != nil || tz != "" {
+		t.Errorf("Timezone() = %q, %v, want empty timezone in the open sea", tz, err)
+	}
+
+	r.TilesetID = ""
+	if _, err := r.Timezone(context.Background(), GeoPoint{}); err == nil {
+		t.Error("tileset id error expected")
+	}
+}
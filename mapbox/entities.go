@@ -11,14 +11,18 @@ type (
 		PlaceName   string     `json:"place_name"`
 		Center      []float64  `json:"center"`
 		Geometry    Geometry   `json:"geometry"`
-		Address     string     `json:"address"`
-		Context     []Context  `json:"context"`
-		BoundingBox []float64  `json:"bbox"`
+		Address     string     `json:"address,omitempty"`
+		Context     []Context  `json:"context,omitempty"`
+		BoundingBox []float64  `json:"bbox,omitempty"`
 	}
 
 	Properties struct {
-		Accuracy  string `json:"accuracy"`
-		ShortCode string `json:"short_code"`
+		Accuracy  Accuracy `json:"accuracy,omitempty"`
+		ShortCode string   `json:"short_code,omitempty"`
+		Wikidata  string   `json:"wikidata,omitempty"`
+		Category  string   `json:"category,omitempty"`
+		Landmark  bool     `json:"landmark,omitempty"`
+		Maki      string   `json:"maki,omitempty"`
 	}
 
 	Geometry struct {
@@ -29,7 +33,7 @@ type (
 	Context struct {
 		ID        string `json:"id"`
 		Text      string `json:"text"`
-		Wikidata  string `json:"wikidata"`
-		ShortCode string `json:"short_code"`
+		Wikidata  string `json:"wikidata,omitempty"`
+		ShortCode string `json:"short_code,omitempty"`
 	}
 )
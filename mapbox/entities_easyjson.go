@@ -37,9 +37,17 @@ func easyjson3e8ab7adDecodeGithubComHumansNetMapboxSdkGoMapbox(in *jlexer.Lexer,
 		}
 		switch key {
 		case "accuracy":
-			out.Accuracy = string(in.String())
+			out.Accuracy = Accuracy(in.String())
 		case "short_code":
 			out.ShortCode = string(in.String())
+		case "wikidata":
+			out.Wikidata = string(in.String())
+		case "category":
+			out.Category = string(in.String())
+		case "landmark":
+			out.Landmark = bool(in.Bool())
+		case "maki":
+			out.Maki = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -54,16 +62,66 @@ func easyjson3e8ab7adEncodeGithubComHumansNetMapboxSdkGoMapbox(out *jwriter.Writ
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
+	if in.Accuracy != "" {
 		const prefix string = ",\"accuracy\":"
-		out.RawString(prefix[1:])
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
 		out.String(string(in.Accuracy))
 	}
-	{
+	if in.ShortCode != "" {
 		const prefix string = ",\"short_code\":"
-		out.RawString(prefix)
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
 		out.String(string(in.ShortCode))
 	}
+	if in.Wikidata != "" {
+		const prefix string = ",\"wikidata\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Wikidata))
+	}
+	if in.Category != "" {
+		const prefix string = ",\"category\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Category))
+	}
+	if in.Landmark {
+		const prefix string = ",\"landmark\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Landmark))
+	}
+	if in.Maki != "" {
+		const prefix string = ",\"maki\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Maki))
+	}
 	out.RawByte('}')
 }
 
@@ -403,12 +461,12 @@ func easyjson3e8ab7adEncodeGithubComHumansNetMapboxSdkGoMapbox2(out *jwriter.Wri
 		out.RawString(prefix)
 		(in.Geometry).MarshalEasyJSON(out)
 	}
-	{
+	if in.Address != "" {
 		const prefix string = ",\"address\":"
 		out.RawString(prefix)
 		out.String(string(in.Address))
 	}
-	{
+	if len(in.Context) != 0 {
 		const prefix string = ",\"context\":"
 		out.RawString(prefix)
 		if in.Context == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
@@ -424,7 +482,7 @@ func easyjson3e8ab7adEncodeGithubComHumansNetMapboxSdkGoMapbox2(out *jwriter.Wri
 			out.RawByte(']')
 		}
 	}
-	{
+	if len(in.BoundingBox) != 0 {
 		const prefix string = ",\"bbox\":"
 		out.RawString(prefix)
 		if in.BoundingBox == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
@@ -517,12 +575,12 @@ func easyjson3e8ab7adEncodeGithubComHumansNetMapboxSdkGoMapbox3(out *jwriter.Wri
 		out.RawString(prefix)
 		out.String(string(in.Text))
 	}
-	{
+	if in.Wikidata != "" {
 		const prefix string = ",\"wikidata\":"
 		out.RawString(prefix)
 		out.String(string(in.Wikidata))
 	}
-	{
+	if in.ShortCode != "" {
 		const prefix string = ",\"short_code\":"
 		out.RawString(prefix)
 		out.String(string(in.ShortCode))
@@ -0,0 +1,40 @@
+package mapbox
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFeature_MarshalJSON(t *testing.T) {
+	resp := rawReverseGeoResp{}
+	if err := resp.UnmarshalJSON(testRespBody); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range resp.Features {
+		b, err := f.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(b), "null") {
+			t.Errorf("feature %s is not a valid GeoJSON: %s", f.ID, b)
+		}
+
+		got := Feature{}
+		if err := got.UnmarshalJSON(b); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, f) {
+			t.Errorf("feature %s roundtrip mismatch:\n%+v\n%+v", f.ID, got, f)
+		}
+	}
+
+	b, _ := resp.Features[len(resp.Features)-1].MarshalJSON()
+	want := `{"id":"country.9053006287256050","type":"Feature","place_type":["country"],"relevance":1,` +
+		`"properties":{"short_code":"us","wikidata":"Q30"},"text":"United States","place_name":"United States",` +
+		`"center":[-100,40],"geometry":{"type":"Point","coordinates":[-100,40]},"bbox":[-179.9,18.765563,-66.885444,71.540724]}`
+	if string(b) != want {
+		t.Errorf("MarshalJSON() = %s", b)
+	}
+}
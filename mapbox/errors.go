@@ -0,0 +1,56 @@
+package mapbox
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StatusError is returned when mapbox responds with unexpected status code, use errors.As to inspect it.
+type StatusError struct {
+	// Op is the failed operation, e.g. get directions.
+	Op         string
+	URI        string
+	StatusCode int
+	// RequestID is the mapbox request id response header.
+	RequestID string
+	// Body is the raw response body, it usually has mapbox error message.
+	Body []byte
+}
+
+func (e *StatusError) Error() string {
+	if e.URI == "" {
+		return fmt.Sprintf("failed to %s statusCode %d request id %s resp %s", e.Op, e.StatusCode, e.RequestID, string(e.Body))
+	}
+	return fmt.Sprintf("failed to %s URI %s statusCode %d request id %s resp %s", e.Op, e.URI, e.StatusCode, e.RequestID, string(e.Body))
+}
+
+// newStatusError redacts access token in uri, so errors could be logged as is.
+func newStatusError(op, uri string, statusCode int, requestID string, body []byte) error {
+	return withStack(&StatusError{Op: op, URI: redactAccessToken(uri), StatusCode: statusCode, RequestID: requestID, Body: body})
+}
+
+// redactAccessToken replaces access_token query param values in uri.
+func redactAccessToken(uri string) string {
+	const param = access_token + "="
+
+	for i := 0; ; {
+		j := strings.Index(uri[i:], param)
+		if j < 0 {
+			return uri
+		}
+		start := i + j + len(param)
+		end := strings.IndexByte(uri[start:], '&')
+		if end < 0 {
+			end = len(uri)
+		} else {
+			end += start
+		}
+		uri = uri[:start] + redacted + uri[end:]
+		i = start + len(redacted)
+	}
+}
+
+// errorf is fmt.Errorf with stack trace attached in debug builds, see withStack.
+func errorf(format string, args ...interface{}) error {
+	return withStack(fmt.Errorf(format, args...))
+}
@@ -0,0 +1,60 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestStatusError(t *testing.T) {
+	d := NewFastHttpDirections(AccessToken("sk.secret"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.Header.Set("X-Request-Id", "req-1")
+			resp.SetStatusCode(fasthttp.StatusUnprocessableEntity)
+			resp.SetBodyString(`{"message":"invalid"}`)
+			return nil
+		})))
+
+	_, err := d.Directions(context.Background(), &DirectionsRequest{
+		Profile:     ProfileDriving,
+		Coordinates: []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}},
+	})
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("Directions() error = %v, want StatusError", err)
+	}
+	if statusErr.Op != "get directions" || statusErr.StatusCode != fasthttp.StatusUnprocessableEntity ||
+		statusErr.RequestID != "req-1" || string(statusErr.Body) != `{"message":"invalid"}` {
+		t.Errorf("unexpected status error %+v", statusErr)
+	}
+	if strings.Contains(err.Error(), "sk.secret") || !strings.Contains(statusErr.URI, "?access_token=redacted") {
+		t.Errorf("access token is not redacted %s", err)
+	}
+}
+
+func Test_redactAccessToken(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{uri: "https://api.mapbox.com/a?access_token=sk.secret&limit=1", want: "https://api.mapbox.com/a?access_token=redacted&limit=1"},
+		{uri: "https://api.mapbox.com/a?limit=1&access_token=pk.public", want: "https://api.mapbox.com/a?limit=1&access_token=redacted"},
+		{uri: "https://api.mapbox.com/a?limit=1", want: "https://api.mapbox.com/a?limit=1"},
+	}
+	for _, tt := range tests {
+		if got := redactAccessToken(tt.uri); got != tt.want {
+			t.Errorf("redactAccessToken(%s) = %s, want %s", tt.uri, got, tt.want)
+		}
+	}
+}
+
+func TestErrorWrapping(t *testing.T) {
+	err := errorf("failed to warmup %s: %w", "host", ErrClosed)
+	if !errors.Is(err, ErrClosed) || err.Error() != "failed to warmup host: mapbox client is closed" {
+		t.Errorf("errorf() = %v", err)
+	}
+}
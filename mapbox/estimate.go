@@ -0,0 +1,74 @@
+package mapbox
+
+// SKU is a billable mapbox product.
+type SKU string
+
+const (
+	SKUTemporaryGeocoding SKU = "temporary_geocoding"
+	SKUPermanentGeocoding SKU = "permanent_geocoding"
+	SKUMatrixElements     SKU = "matrix_elements"
+	SKUStaticImages       SKU = "static_images"
+)
+
+// MaxMatrixElements is the maximum number of elements, sources times destinations, of a single matrix request.
+const MaxMatrixElements = 25 * 25
+
+// BatchJob describes a planned batch job to estimate its cost with EstimateCost.
+type BatchJob struct {
+	// ForwardGeocodes and ReverseGeocodes are numbers of geocoded queries and points.
+	ForwardGeocodes int
+	ReverseGeocodes int
+	// Permanent geocoding is billed separately from temporary one, see Permanent.
+	Permanent bool
+	// BatchGeocode sends forward geocodes with v6 batch API, every query is still billed.
+	BatchGeocode bool
+	// MatrixElements is a number of source and destination pairs.
+	MatrixElements int
+	// StaticImages is a number of rendered images.
+	StaticImages int
+}
+
+// SKUEstimate is a number of requests and billable units of a SKU.
+type SKUEstimate struct {
+	SKU SKU
+	// Requests is a number of API calls without retries.
+	Requests int
+	// Billable is a number of units mapbox bills, e.g. matrix elements.
+	Billable int
+}
+
+// EstimateCost estimates request counts per billable SKU of a batch job, so cost could be reviewed before launch.
+// SKUs with no requests are skipped. Free tiers and prices are not taken into account.
+func EstimateCost(job BatchJob) []SKUEstimate {
+	var estimates []SKUEstimate
+
+	geocodeSKU := SKUTemporaryGeocoding
+	if job.Permanent {
+		geocodeSKU = SKUPermanentGeocoding
+	}
+	if geocodes := job.ForwardGeocodes + job.ReverseGeocodes; geocodes > 0 {
+		requests := geocodes
+		if job.BatchGeocode {
+			requests = ceilDiv(job.ForwardGeocodes, MaxBatchGeocodeQueries) + job.ReverseGeocodes
+		}
+		estimates = append(estimates, SKUEstimate{SKU: geocodeSKU, Requests: requests, Billable: geocodes})
+	}
+
+	if job.MatrixElements > 0 {
+		estimates = append(estimates, SKUEstimate{
+			SKU:      SKUMatrixElements,
+			Requests: ceilDiv(job.MatrixElements, MaxMatrixElements),
+			Billable: job.MatrixElements,
+		})
+	}
+
+	if job.StaticImages > 0 {
+		estimates = append(estimates, SKUEstimate{SKU: SKUStaticImages, Requests: job.StaticImages, Billable: job.StaticImages})
+	}
+
+	return estimates
+}
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,42 @@
+package mapbox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name string
+		job  BatchJob
+		want []SKUEstimate
+	}{
+		{name: "empty"},
+		{
+			name: "temporary geocoding",
+			job:  BatchJob{ForwardGeocodes: 10, ReverseGeocodes: 5},
+			want: []SKUEstimate{{SKU: SKUTemporaryGeocoding, Requests: 15, Billable: 15}},
+		},
+		{
+			name: "permanent batch geocoding",
+			job:  BatchJob{ForwardGeocodes: 2500, ReverseGeocodes: 5, Permanent: true, BatchGeocode: true},
+			want: []SKUEstimate{{SKU: SKUPermanentGeocoding, Requests: 8, Billable: 2505}},
+		},
+		{
+			name: "matrix and static images",
+			job:  BatchJob{MatrixElements: 1000, StaticImages: 3},
+			want: []SKUEstimate{
+				{SKU: SKUMatrixElements, Requests: 2, Billable: 1000},
+				{SKU: SKUStaticImages, Requests: 3, Billable: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EstimateCost(tt.job); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EstimateCost() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
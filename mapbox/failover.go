@@ -0,0 +1,100 @@
+package mapbox
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const defaultFailoverCooldown = 30 * time.Second
+
+// FailoverOptions tunes failover between RootAPI and secondary root apis.
+type FailoverOptions struct {
+	// RootAPIs are tried in order when RootAPI fails, e.g. a caching proxy.
+	RootAPIs []string
+	// LatencyBudget marks a host unhealthy if its response took longer, the response itself is still returned.
+	// 0 disables the check.
+	LatencyBudget time.Duration
+	// Cooldown is a time an unhealthy host is skipped for, default to 30s.
+	Cooldown time.Duration
+}
+
+// Failover enables health based failover, calls are sent to the first healthy root api
+// and retried with the next one on transport errors and 5xx responses.
+// If every host is unhealthy they are still tried in order.
+func Failover(opts FailoverOptions) Option {
+	return func(c config) config {
+		c.failover = &opts
+		return c
+	}
+}
+
+// hostPool tracks root apis health, it is shared by config copies.
+type hostPool struct {
+	cooldown      time.Duration
+	latencyBudget time.Duration
+
+	mu        sync.Mutex
+	hosts     []string
+	downUntil []time.Time
+}
+
+func newHostPool(rootAPI string, opts *FailoverOptions) (*hostPool, error) {
+	hosts := append([]string{rootAPI}, opts.RootAPIs...)
+	for _, h := range opts.RootAPIs {
+		if u, err := url.Parse(h); err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, errorf("invalid failover root api %q", h)
+		}
+	}
+
+	cooldown := opts.Cooldown
+	if cooldown <= 0 {
+		cooldown = defaultFailoverCooldown
+	}
+
+	return &hostPool{
+		cooldown:      cooldown,
+		latencyBudget: opts.LatencyBudget,
+		hosts:         hosts,
+		downUntil:     make([]time.Time, len(hosts)),
+	}, nil
+}
+
+// order returns hosts indexes, healthy hosts go first keeping configured priority.
+func (p *hostPool) order(now time.Time) []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	order := make([]int, 0, len(p.hosts))
+	for i := range p.hosts {
+		if !now.Before(p.downUntil[i]) {
+			order = append(order, i)
+		}
+	}
+	for i := range p.hosts {
+		if now.Before(p.downUntil[i]) {
+			order = append(order, i)
+		}
+	}
+	return order
+}
+
+// report updates host health with a call result.
+func (p *hostPool) report(i int, resp *rawResponse, err error, now time.Time) {
+	healthy := !failedOver(resp, err) && (p.latencyBudget == 0 || resp.meta.Duration <= p.latencyBudget)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if healthy {
+		p.downUntil[i] = time.Time{}
+	} else {
+		p.downUntil[i] = now.Add(p.cooldown)
+	}
+}
+
+// failedOver reports whether call should be retried with the next host.
+func failedOver(resp *rawResponse, err error) bool {
+	return err != nil || resp.statusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,87 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFailover(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+
+	var (
+		hosts       []string
+		primaryDown = true
+		proxySlow   = false
+	)
+	c := NewFastHttpStyles(
+		WithClock(clock),
+		RootAPI("https://primary"),
+		Failover(FailoverOptions{
+			RootAPIs:      []string{"https://proxy"},
+			LatencyBudget: time.Second,
+			Cooldown:      time.Minute,
+		}),
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			host := uri[len("https://"):strings.Index(uri, "/styles")]
+			hosts = append(hosts, host)
+
+			switch {
+			case host == "primary" && primaryDown:
+				return errors.New("connection refused")
+			case host == "proxy" && proxySlow:
+				clock.now = clock.now.Add(2 * time.Second)
+			}
+			resp.SetBodyString(`[]`)
+			return nil
+		})),
+	)
+
+	call := func() *ListStylesResponse {
+		t.Helper()
+		resp, err := c.ListStyles(context.Background(), &ListStylesRequest{Username: "user"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	tests := []struct {
+		name         string
+		primaryDown  bool
+		proxySlow    bool
+		advance      time.Duration
+		wantHosts    string
+		wantAttempts int
+	}{
+		{name: "primary error falls back", primaryDown: true, wantHosts: "primary,proxy", wantAttempts: 2},
+		{name: "unhealthy primary skipped", wantHosts: "proxy", wantAttempts: 1},
+		{name: "slow proxy marked unhealthy", proxySlow: true, wantHosts: "proxy", wantAttempts: 1},
+		{name: "both unhealthy tried in order", wantHosts: "primary", wantAttempts: 1},
+		{name: "recovered primary preferred", advance: time.Minute, wantHosts: "primary", wantAttempts: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hosts = nil
+			primaryDown, proxySlow = tt.primaryDown, tt.proxySlow
+			clock.now = clock.now.Add(tt.advance)
+
+			resp := call()
+			if got := strings.Join(hosts, ","); got != tt.wantHosts {
+				t.Errorf("hosts = %s, want %s", got, tt.wantHosts)
+			}
+			if resp.Meta.Attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", resp.Meta.Attempts, tt.wantAttempts)
+			}
+		})
+	}
+
+	if err := NewFastHttpStyles(Failover(FailoverOptions{RootAPIs: []string{"proxy"}})).err; err == nil {
+		t.Error("invalid failover root api error expected")
+	}
+}
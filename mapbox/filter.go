@@ -0,0 +1,49 @@
+package mapbox
+
+// Thresholds filter geocode features before they are returned.
+type Thresholds struct {
+	// MinRelevance drops features with lower relevance, 0 disables the check.
+	MinRelevance float64
+	// MinAccuracy drops features with less precise accuracy, empty disables the check.
+	// Features without accuracy, i.e. not addresses, are kept.
+	MinAccuracy Accuracy
+}
+
+// FilterFeatures sets default thresholds for geocode calls, requests could override them.
+func FilterFeatures(t Thresholds) Option {
+	return func(c config) config {
+		c.thresholds = t
+		return c
+	}
+}
+
+// merge returns t with fields set in override replaced.
+func (t Thresholds) merge(override Thresholds) Thresholds {
+	if override.MinRelevance != 0 {
+		t.MinRelevance = override.MinRelevance
+	}
+	if override.MinAccuracy != "" {
+		t.MinAccuracy = override.MinAccuracy
+	}
+	return t
+}
+
+// filter drops features below thresholds in place and returns number of dropped features.
+func (t Thresholds) filter(features []Feature) ([]Feature, int) {
+	if t == (Thresholds{}) {
+		return features, 0
+	}
+
+	kept := features[:0]
+	for _, f := range features {
+		if f.Relevance < t.MinRelevance {
+			continue
+		}
+		if t.MinAccuracy != "" && f.Properties.Accuracy != "" && !f.Properties.Accuracy.IsAtLeast(t.MinAccuracy) {
+			continue
+		}
+		kept = append(kept, f)
+	}
+
+	return kept, len(features) - len(kept)
+}
@@ -0,0 +1,61 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFilterFeatures(t *testing.T) {
+	client := HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		resp.SetBodyString(`{"type":"FeatureCollection","query":["a"],"features":[
+			{"id":"address.1","relevance":0.9,"properties":{"accuracy":"rooftop"}},
+			{"id":"address.2","relevance":0.9,"properties":{"accuracy":"interpolated"}},
+			{"id":"address.3","relevance":0.5,"properties":{"accuracy":"rooftop"}},
+			{"id":"place.1","relevance":0.9,"properties":{}}
+		]}`)
+		return nil
+	}))
+
+	tests := []struct {
+		name         string
+		defaults     Thresholds
+		req          Thresholds
+		wantIDs      []string
+		wantFiltered int
+	}{
+		{name: "no thresholds", wantIDs: []string{"address.1", "address.2", "address.3", "place.1"}},
+		{name: "min relevance", defaults: Thresholds{MinRelevance: 0.8}, wantIDs: []string{"address.1", "address.2", "place.1"}, wantFiltered: 1},
+		{name: "min accuracy keeps features without accuracy", req: Thresholds{MinAccuracy: AccuracyPoint}, wantIDs: []string{"address.1", "address.3", "place.1"}, wantFiltered: 1},
+		{
+			name:         "request overrides defaults",
+			defaults:     Thresholds{MinRelevance: 0.8, MinAccuracy: AccuracyRooftop},
+			req:          Thresholds{MinRelevance: 0.4},
+			wantIDs:      []string{"address.1", "address.3", "place.1"},
+			wantFiltered: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewFastHttpGeocoder(client, FilterFeatures(tt.defaults))
+			resp, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "a", Thresholds: tt.req})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ids := make([]string, 0, len(resp.Features))
+			for _, f := range resp.Features {
+				ids = append(ids, f.ID)
+			}
+			if len(ids) != len(tt.wantIDs) || resp.Filtered != tt.wantFiltered {
+				t.Fatalf("got %v filtered %d, want %v filtered %d", ids, resp.Filtered, tt.wantIDs, tt.wantFiltered)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Errorf("got %v, want %v", ids, tt.wantIDs)
+				}
+			}
+		})
+	}
+}
@@ -2,13 +2,13 @@ package mapbox
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
-	"github.com/pkg/errors"
-
+	"github.com/mailru/easyjson/jlexer"
 	"github.com/valyala/fasthttp"
 )
 
@@ -23,6 +23,7 @@ const (
 	bbox         = "bbox"
 	proximity    = "proximity"
 	routing      = "routing"
+	worldview    = "worldview"
 	trueStr      = "true"
 	oneStr       = "1"
 
@@ -62,6 +63,7 @@ type ReverseGeocodeRequest struct {
 	// More than one value can also be specified, separated by commas,
 	// for applications that need to display labels in multiple languages.
 	// For more information on which specific languages are supported, see https://docs.mapbox.com/api/search/#language-coverage
+	// Default to language set with WithContextLanguage.
 	Language string
 	// Decides how results are sorted in a reverse geocoding query
 	// if multiple results are requested using a limit other than 1.
@@ -77,6 +79,14 @@ type ReverseGeocodeRequest struct {
 	// Consuming applications should fall back to using the feature’s normal geometry for routing
 	// if a separate routable point is not returned.
 	Routing bool
+	// Enrich derives country code and, if TimezoneLookup option is set, timezone of the GeoPoint.
+	// Result is returned in GeocodeResponse.Enrichment.
+	Enrich bool
+	// Worldview returns features for disputed boundaries as seen from the country: cn, in, jp or us, default us.
+	Worldview string
+	// Thresholds override thresholds set with FilterFeatures option.
+	// Enrichment is derived before features are filtered.
+	Thresholds Thresholds
 }
 
 // RateLimit wraps mapbox API rate limit resp headers
@@ -88,8 +98,8 @@ type RateLimit struct {
 
 // easyjson:json
 type rawReverseGeoResp struct {
-	Features []Feature `json:"features"`
-	Query    []float64 `json:"query"`
+	Features []Feature       `json:"features"`
+	Query    json.RawMessage `json:"query"`
 }
 
 // easyjson:json
@@ -98,18 +108,98 @@ type rawForwardGeoResp struct {
 	Query    []string  `json:"query"`
 }
 
+// Query is a query mapbox echoes in geocode response.
+type Query struct {
+	// Point is set for reverse geocoding.
+	Point *GeoPoint
+	// Text is forward geocoding query tokens joined with space.
+	Text string
+	// Tokens are normalized forward geocoding query words, e.g. lowercased.
+	Tokens []string
+	// Raw is reverse geocoding query echo as returned by mapbox,
+	// Point is nil if it has no lon,lat pair.
+	Raw []byte
+}
+
+// queryGeoPoint parses reverse geocoding query echo lon,lat pair, e.g. [-77.05,38.889].
+// Extra elements are ignored and numbers encoded as strings are accepted, ok is false if there is no pair.
+func queryGeoPoint(raw []byte) (GeoPoint, bool) {
+	var lonLat []float64
+
+	in := jlexer.Lexer{Data: raw}
+	in.Delim('[')
+	for !in.IsDelim(']') && in.Ok() {
+		switch v := in.Interface().(type) {
+		case float64:
+			lonLat = append(lonLat, v)
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return GeoPoint{}, false
+			}
+			lonLat = append(lonLat, f)
+		default:
+			return GeoPoint{}, false
+		}
+		in.WantComma()
+	}
+	in.Delim(']')
+
+	if in.Error() != nil || len(lonLat) < 2 {
+		return GeoPoint{}, false
+	}
+
+	return GeoPoint{Lon: lonLat[0], Lat: lonLat[1]}, true
+}
+
 // GeocodeResponse
 type GeocodeResponse struct {
 	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
 	// Raw mapbox API response
 	RawResp []byte
 	// passed query to mapbox
 	ReverseQuery GeoPoint
 	ForwardQuery []string
+	// Query is the same query normalized for both forward and reverse geocoding
+	Query Query
 	// response result type
 	Type string
 	// response data
 	Features []Feature
+	// Filtered is the number of features dropped by Thresholds, bias profile countries and GeofenceResults
+	Filtered int
+	// DecodeErrors are features skipped with SoftFailDecoding option
+	DecodeErrors []FeatureDecodeError
+	// Enrichment is set for reverse geocode requests with Enrich flag
+	Enrichment *Enrichment
+	// Decoded is set instead of the fields above if CustomDecoder is registered for the endpoint
+	Decoded interface{}
+	// Permanent results could be stored, it is false if PermanentFallback option downgraded the call.
+	Permanent bool
+
+	// contexts is set with ReuseContexts option.
+	contexts *contextPool
+	config   *config
+}
+
+// Retain returns raw response to store, see StrictStorage.
+func (r *GeocodeResponse) Retain() ([]byte, error) {
+	return r.config.retain(r.Permanent, r.RawResp)
+}
+
+// Release returns features context slices to the pool if ReuseContexts option is set,
+// features contexts must not be used after it.
+func (r *GeocodeResponse) Release() {
+	if r.contexts == nil {
+		return
+	}
+	for i := range r.Features {
+		r.contexts.releaseContexts(r.Features[i].Context)
+		r.Features[i].Context = nil
+	}
+	r.contexts = nil
 }
 
 type ForwardGeocodeRequest struct {
@@ -117,9 +207,9 @@ type ForwardGeocodeRequest struct {
 	//This could be an address, a point of interest name, a city name, etc.
 	//When searching for points of interest, it can also be a category name (for example, “coffee shop”).
 	//For information on categories, see the Point of interest category coverage section.
-	//The search text should be expressed as a URL-encoded UTF-8 string,
-	//and must not contain the semicolon character (either raw or URL-encoded).
-	//Your search text, once decoded, must consist of at most 20 words and numbers separated by spacing and punctuation,
+	//The search text is raw UTF-8 text, SDK URL-encodes it, so it must not be encoded by callers.
+	//It must not contain the semicolon character.
+	//Your search text must consist of at most 20 words and numbers separated by spacing and punctuation,
 	//and at most 256 characters.
 	//
 	//The accuracy of coordinates returned by a forward geocoding request can be impacted
@@ -170,6 +260,7 @@ type ForwardGeocodeRequest struct {
 	//for applications that need to display labels in multiple languages.
 	//
 	//For more information on which specific languages are supported, see the https://docs.mapbox.com/api/search/#language-coverage.
+	//Default to language set with WithContextLanguage.
 	Language string
 
 	//Specify the maximum number of results to return. The default is 5 and the maximum supported is 10.
@@ -195,14 +286,28 @@ type ForwardGeocodeRequest struct {
 	//
 	//For more information on the available types, see the https://docs.mapbox.com/api/search/#data-types.
 	Types []string
+
+	//Returns features for disputed boundaries as seen from the country.
+	//Options are cn, in, jp and us, default us.
+	Worldview string
+
+	//Thresholds override thresholds set with FilterFeatures option.
+	Thresholds Thresholds
+
+	//BiasProfile selects a profile set with BiasProfiles option, its fields are used if request ones are empty.
+	BiasProfile string
+
+	//TypesPreset sets Types to a recommended set of a product surface, e.g. TypesAddressEntry,
+	//it is used if neither request nor bias profile sets Types.
+	TypesPreset TypesPreset
 }
 
 // Geocoder encapsulates forward and reverse geocode calls.
 type Geocoder interface {
 	// ReverseGeocode calls geocode/v5 reverse mapbox API
-	ReverseGeocode(ctx context.Context, req *ReverseGeocodeRequest) (*GeocodeResponse, error)
+	ReverseGeocode(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption) (*GeocodeResponse, error)
 	// ReverseGeocode calls geocode/v5 reverse mapbox API
-	ForwardGeocode(ctx context.Context, req *ForwardGeocodeRequest) (*GeocodeResponse, error)
+	ForwardGeocode(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption) (*GeocodeResponse, error)
 }
 
 // FastHttpGeocoder is a fasthttp Geocoder implementation
@@ -210,20 +315,18 @@ type FastHttpGeocoder struct {
 	config
 
 	geocodeAPIURL []byte
+	// temporaryAPIURL is used instead of permanent geocodeAPIURL with PermanentFallback option.
+	temporaryAPIURL []byte
 
 	stringBufPull *stringsBufferPool
 }
 
 // ReverseGeocode calls geocode/v5 reverse mapbox API thought fasthttp client.
-func (c *FastHttpGeocoder) ReverseGeocode(ctx context.Context, req *ReverseGeocodeRequest) (*GeocodeResponse, error) {
-	freq := fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(freq)
-
-	fresp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(fresp)
+func (c *FastHttpGeocoder) ReverseGeocode(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption) (*GeocodeResponse, error) {
+	ctx = withCallOptions(ctx, opts)
 
 	// split multivalues to limit memory consumption
-	values := make(map[string]string, 5)
+	values := make(map[string]string, 6)
 
 	if req.Country != "" {
 		values[country] = req.Country
@@ -231,8 +334,8 @@ func (c *FastHttpGeocoder) ReverseGeocode(ctx context.Context, req *ReverseGeoco
 	if req.Limit != 0 {
 		values[limit] = strconv.Itoa(req.Limit)
 	}
-	if req.Language != "" {
-		values[language] = req.Language
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
 	}
 	if req.Routing {
 		values[routing] = trueStr
@@ -243,14 +346,18 @@ func (c *FastHttpGeocoder) ReverseGeocode(ctx context.Context, req *ReverseGeoco
 	if len(req.Types) > 0 {
 		values[types] = strings.Join(req.Types, ",")
 	}
+	if req.Worldview != "" {
+		values[worldview] = req.Worldview
+	}
 
 	buf := c.stringBufPull.acquireStringsBuilder()
 	defer c.stringBufPull.releaseStringsBuilder(buf)
 
+	coordinate := strconv.FormatFloat(req.GeoPoint.Lon, floatFormatNoExponent, 6, 64) + string(comma) +
+		strconv.FormatFloat(req.GeoPoint.Lat, floatFormatNoExponent, 6, 64)
+
 	buf.Write(c.geocodeAPIURL)
-	buf.WriteString(strconv.FormatFloat(req.GeoPoint.Lon, floatFormatNoExponent, 6, 64))
-	buf.WriteByte(comma)
-	buf.WriteString(strconv.FormatFloat(req.GeoPoint.Lat, floatFormatNoExponent, 6, 64))
+	buf.WriteString(coordinate)
 	buf.Write(responseFormatJSON)
 	buf.Write(c.accessTokenGetValue)
 
@@ -258,59 +365,101 @@ func (c *FastHttpGeocoder) ReverseGeocode(ctx context.Context, req *ReverseGeoco
 
 	reqURI := buf.Bytes()
 
-	c.withLogger(ctx, func(logger Logger) {
-		logger.Debugf("mapbox_sdk: reverse geocode request %s", buf.String())
-	})
+	c.logRequest(ctx, "reverse geocode", reqURI, values,
+		logKeyEndpoint, string(c.geocodeEndpoint), logKeyCoordinate, coordinate)
 
-	freq.Header.SetMethodBytes(getMethod)
-	freq.SetRequestURIBytes(reqURI)
-
-	if err := c.client.Do(freq, fresp); err != nil {
+	raw, permanent, err := c.doGeocode(ctx, "reverse geocode", reqURI)
+	if err != nil {
 		return nil, err
 	}
 
-	respBytes := make([]byte, len(fresp.Body()))
-	copy(respBytes, fresp.Body())
+	respBytes := raw.body
+
+	c.logResponse(ctx, "reverse geocode", raw.statusCode, raw.meta.RequestID, respBytes)
 
-	c.withLogger(ctx, func(logger Logger) {
-		logger.Debugf("mapbox_sdk: reverse geocode response %s", string(respBytes))
-	})
+	if raw.statusCode != http.StatusOK {
+		return nil, newStatusError("reverse geocode", string(reqURI), raw.statusCode, raw.meta.RequestID, respBytes)
+	}
 
-	if fresp.Header.StatusCode() != http.StatusOK {
-		return nil, errors.Errorf("failed to reverse geocode URI %s statusCode %d resp %s",
-			reqURI, fresp.Header.StatusCode(), string(respBytes))
+	if decoded, ok, err := c.customDecode(EndpointReverseGeocode, respBytes); ok {
+		if err != nil {
+			return nil, err
+		}
+		return &GeocodeResponse{
+			RateLimit: raw.rateLimit,
+			Meta:      raw.meta,
+			RawResp:   respBytes,
+			Decoded:   decoded,
+			Permanent: permanent,
+			config:    &c.config,
+		}, nil
+	}
+
+	if err := c.checkSchema(geocodeSchema, respBytes); err != nil {
+		return nil, err
 	}
 
 	respRaw := rawReverseGeoResp{}
-	if err := respRaw.UnmarshalJSON(respBytes); err != nil {
-		return nil, errors.Wrapf(err, "failed to unmarshall raw reverse geocode resp %s", string(respBytes))
+	decoded := c.timeDecode(raw)
+	decodeErrs, err := c.unmarshalReverse(&respRaw, respBytes)
+	decoded()
+	if err != nil {
+		return nil, errorf("failed to unmarshall raw reverse geocode resp %s: %w", string(respBytes), err)
 	}
 
-	if len(respRaw.Query) != 2 {
-		return nil, errors.Errorf("unexpected len of query coordinates in resp %s", string(respBytes))
+	resp := &GeocodeResponse{
+		RateLimit:    raw.rateLimit,
+		Meta:         raw.meta,
+		RawResp:      respBytes,
+		Query:        Query{Raw: respRaw.Query},
+		Features:     respRaw.Features,
+		DecodeErrors: decodeErrs,
+		Permanent:    permanent,
+		contexts:     c.contexts,
+		config:       &c.config,
 	}
 
-	return &GeocodeResponse{
-		RateLimit: readRespRateLimit(fresp),
-		RawResp:   respBytes,
-		ReverseQuery: GeoPoint{
-			Lon: respRaw.Query[0],
-			Lat: respRaw.Query[1],
-		},
-		Features: respRaw.Features,
-	}, nil
+	if point, ok := queryGeoPoint(respRaw.Query); ok {
+		resp.ReverseQuery = point
+		resp.Query.Point = &point
+	}
+
+	if req.Enrich {
+		enrichment, err := c.enrich(ctx, req.GeoPoint, resp.Features)
+		if err != nil {
+			return nil, err
+		}
+		resp.Enrichment = enrichment
+	}
+
+	resp.Features, resp.Filtered = c.thresholds.merge(req.Thresholds).filter(resp.Features)
+
+	return resp, nil
 }
 
 // ReverseGeocode calls geocode/v5 reverse mapbox API thought fasthttp client.
-func (c *FastHttpGeocoder) ForwardGeocode(ctx context.Context, req *ForwardGeocodeRequest) (*GeocodeResponse, error) {
-	freq := fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(freq)
+func (c *FastHttpGeocoder) ForwardGeocode(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption) (*GeocodeResponse, error) {
+	ctx = withCallOptions(ctx, opts)
 
-	fresp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(fresp)
+	profile, err := c.biasProfile(req.BiasProfile)
+	if err != nil {
+		return nil, err
+	}
+	if req.BiasProfile != "" {
+		req = profile.apply(req)
+	}
+	if req.TypesPreset != "" && len(req.Types) == 0 {
+		types, err := c.presetTypes(req.TypesPreset, req.Country)
+		if err != nil {
+			return nil, err
+		}
+		preset := *req
+		preset.Types = types
+		req = &preset
+	}
 
 	// split multivalues to limit memory consumption
-	values := make(map[string]string, 9)
+	values := make(map[string]string, 10)
 
 	if req.Country != "" {
 		values[country] = req.Country
@@ -318,20 +467,20 @@ func (c *FastHttpGeocoder) ForwardGeocode(ctx context.Context, req *ForwardGeoco
 	if req.Limit != 0 {
 		values[limit] = strconv.Itoa(req.Limit)
 	}
-	if req.Language != "" {
-		values[language] = req.Language
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
 	}
 	if req.Routing {
 		values[routing] = trueStr
 	}
-	if req.Autocomplete != nil {
+	if req.Autocomplete != nil && !(c.omitDefaults && *req.Autocomplete) {
 		values[autocomplete] = fmt.Sprint(*req.Autocomplete)
-	} else {
+	} else if !c.omitDefaults {
 		values[autocomplete] = trueStr
 	}
-	if req.FuzzyMatch != nil {
+	if req.FuzzyMatch != nil && !(c.omitDefaults && *req.FuzzyMatch) {
 		values[fuzzymatch] = fmt.Sprint(*req.FuzzyMatch)
-	} else {
+	} else if !c.omitDefaults {
 		values[fuzzymatch] = trueStr
 	}
 	if len(req.Bbox) == 4 {
@@ -340,16 +489,26 @@ func (c *FastHttpGeocoder) ForwardGeocode(ctx context.Context, req *ForwardGeoco
 	if req.Proximity != nil {
 		values[proximity] = fmt.Sprintf("%f,%f", req.Proximity.Lon, req.Proximity.Lat)
 	}
-	values[routing] = fmt.Sprint(req.Routing)
+	if !c.omitDefaults {
+		values[routing] = fmt.Sprint(req.Routing)
+	}
 	if len(req.Types) > 0 {
 		values[types] = strings.Join(req.Types, ",")
 	}
+	if req.Worldview != "" {
+		values[worldview] = req.Worldview
+	}
 
 	buf := c.stringBufPull.acquireStringsBuilder()
 	defer c.stringBufPull.releaseStringsBuilder(buf)
 
+	searchText := req.SearchText
+	if c.preprocessQuery != nil {
+		searchText = c.preprocessQuery(searchText)
+	}
+
 	buf.Write(c.geocodeAPIURL)
-	buf.WriteString(req.SearchText)
+	writePathEscaped(buf, searchText)
 	buf.Write(responseFormatJSON)
 	buf.Write(c.accessTokenGetValue)
 
@@ -357,57 +516,124 @@ func (c *FastHttpGeocoder) ForwardGeocode(ctx context.Context, req *ForwardGeoco
 
 	reqURI := buf.Bytes()
 
-	c.withLogger(ctx, func(logger Logger) {
-		logger.Debugf("mapbox_sdk: forward geocode request %s", buf.String())
-	})
-
-	freq.Header.SetMethodBytes(getMethod)
-	freq.SetRequestURIBytes(reqURI)
+	c.logRequest(ctx, "forward geocode", reqURI, values,
+		logKeyEndpoint, string(c.geocodeEndpoint), logKeySearchTextLen, strconv.Itoa(len(searchText)))
 
-	if err := c.client.Do(freq, fresp); err != nil {
+	raw, permanent, err := c.doGeocode(ctx, "forward geocode", reqURI)
+	if err != nil {
 		return nil, err
 	}
 
-	respBytes := make([]byte, len(fresp.Body()))
-	copy(respBytes, fresp.Body())
+	respBytes := raw.body
 
-	c.withLogger(ctx, func(logger Logger) {
-		logger.Debugf("mapbox_sdk: forward geocode response %s", string(respBytes))
-	})
+	c.logResponse(ctx, "forward geocode", raw.statusCode, raw.meta.RequestID, respBytes)
 
-	if fresp.Header.StatusCode() != http.StatusOK {
-		return nil, errors.Errorf("failed to reverse geocode URI %s statusCode %d resp %s",
-			reqURI, fresp.Header.StatusCode(), string(respBytes))
+	if raw.statusCode != http.StatusOK {
+		return nil, newStatusError("forward geocode", string(reqURI), raw.statusCode, raw.meta.RequestID, respBytes)
+	}
+
+	if decoded, ok, err := c.customDecode(EndpointForwardGeocode, respBytes); ok {
+		if err != nil {
+			return nil, err
+		}
+		return &GeocodeResponse{
+			RateLimit: raw.rateLimit,
+			Meta:      raw.meta,
+			RawResp:   respBytes,
+			Decoded:   decoded,
+			Permanent: permanent,
+			config:    &c.config,
+		}, nil
+	}
+
+	if err := c.checkSchema(geocodeSchema, respBytes); err != nil {
+		return nil, err
 	}
 
 	respRaw := rawForwardGeoResp{}
-	if err := respRaw.UnmarshalJSON(respBytes); err != nil {
-		return nil, errors.Wrapf(err, "failed to unmarshall raw reverse geocode resp %s", string(respBytes))
+	decoded := c.timeDecode(raw)
+	decodeErrs, err := c.unmarshalForward(&respRaw, respBytes)
+	decoded()
+	if err != nil {
+		return nil, errorf("failed to unmarshall raw forward geocode resp %s: %w", string(respBytes), err)
 	}
 
+	features, filtered := c.thresholds.merge(req.Thresholds).filter(respRaw.Features)
+	features, outside := profile.filter(features)
+	filtered += outside
+	features, outside = c.geofenceFeatures(ctx, "forward geocode", features)
+	filtered += outside
+
 	return &GeocodeResponse{
-		RateLimit:    readRespRateLimit(fresp),
+		RateLimit:    raw.rateLimit,
+		Meta:         raw.meta,
 		RawResp:      respBytes,
-		Features:     respRaw.Features,
+		Features:     features,
+		Filtered:     filtered,
 		ForwardQuery: respRaw.Query,
+		Query:        Query{Text: strings.Join(respRaw.Query, " "), Tokens: respRaw.Query},
+		DecodeErrors: decodeErrs,
+		Permanent:    permanent,
+		contexts:     c.contexts,
+		config:       &c.config,
 	}, nil
 }
 
-func NewFastHttpGeocoder(opts ...Option) *FastHttpGeocoder {
-	c := FastHttpGeocoder{
-		config:        newConfig(),
-		stringBufPull: newStringsBufferPool(),
-		geocodeAPIURL: []byte("/geocoding/v5/"),
+// unmarshalReverse decodes reverse geocode response, decode errors are returned with SoftFailDecoding only.
+func (c *config) unmarshalReverse(r *rawReverseGeoResp, body []byte) ([]FeatureDecodeError, error) {
+	query := func(in *jlexer.Lexer) {
+		r.Query = in.Raw()
 	}
 
-	for _, o := range opts {
-		c.config = o(c.config)
+	var err error
+	if c.contexts == nil {
+		err = r.UnmarshalJSON(body)
+	} else {
+		err = c.contexts.unmarshalGeocode(body, &r.Features, query)
+	}
+	if err == nil || !c.softFail {
+		return nil, err
 	}
 
-	c.config = c.config.withEnv()
-	c.config = c.config.prepare()
+	*r = rawReverseGeoResp{}
+
+	var errs []FeatureDecodeError
+	err = unmarshalGeocodeFields(body, func(in *jlexer.Lexer) {
+		r.Features, errs = unmarshalFeaturesSoft(in)
+	}, query)
+
+	return errs, err
+}
 
-	c.geocodeAPIURL = []byte(c.rootAPI + string(c.geocodeAPIURL) + c.geocodeEndpoint + slash)
+// unmarshalForward decodes forward geocode response, decode errors are returned with SoftFailDecoding only.
+func (c *config) unmarshalForward(r *rawForwardGeoResp, body []byte) ([]FeatureDecodeError, error) {
+	var err error
+	if c.contexts == nil {
+		err = r.UnmarshalJSON(body)
+	} else {
+		err = c.contexts.unmarshalGeocode(body, &r.Features, unmarshalForwardQuery(&r.Query))
+	}
+	if err == nil || !c.softFail {
+		return nil, err
+	}
+
+	*r = rawForwardGeoResp{}
+
+	var errs []FeatureDecodeError
+	err = unmarshalGeocodeFields(body, func(in *jlexer.Lexer) {
+		r.Features, errs = unmarshalFeaturesSoft(in)
+	}, unmarshalForwardQuery(&r.Query))
+
+	return errs, err
+}
+
+func NewFastHttpGeocoder(opts ...Option) *FastHttpGeocoder {
+	c := FastHttpGeocoder{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.geocodeAPIURL = c.apiURL("/geocoding/v5/", string(c.geocodeEndpoint), slash)
+	c.temporaryAPIURL = c.apiURL("/geocoding/v5/", string(PlacesTemporary), slash)
 
 	return &c
 }
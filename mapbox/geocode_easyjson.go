@@ -60,27 +60,8 @@ func easyjson46e924aeDecodeGithubComHumansNetMapboxSdkGoMapbox(in *jlexer.Lexer,
 				in.Delim(']')
 			}
 		case "query":
-			if in.IsNull() {
-				in.Skip()
-				out.Query = nil
-			} else {
-				in.Delim('[')
-				if out.Query == nil {
-					if !in.IsDelim(']') {
-						out.Query = make([]float64, 0, 8)
-					} else {
-						out.Query = []float64{}
-					}
-				} else {
-					out.Query = (out.Query)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v2 float64
-					v2 = float64(in.Float64())
-					out.Query = append(out.Query, v2)
-					in.WantComma()
-				}
-				in.Delim(']')
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Query).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -103,11 +84,11 @@ func easyjson46e924aeEncodeGithubComHumansNetMapboxSdkGoMapbox(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v3, v4 := range in.Features {
-				if v3 > 0 {
+			for v2, v3 := range in.Features {
+				if v2 > 0 {
 					out.RawByte(',')
 				}
-				(v4).MarshalEasyJSON(out)
+				(v3).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -115,18 +96,7 @@ func easyjson46e924aeEncodeGithubComHumansNetMapboxSdkGoMapbox(out *jwriter.Writ
 	{
 		const prefix string = ",\"query\":"
 		out.RawString(prefix)
-		if in.Query == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v5, v6 := range in.Query {
-				if v5 > 0 {
-					out.RawByte(',')
-				}
-				out.Float64(float64(v6))
-			}
-			out.RawByte(']')
-		}
+		out.Raw((in.Query).MarshalJSON())
 	}
 	out.RawByte('}')
 }
@@ -189,9 +159,9 @@ func easyjson46e924aeDecodeGithubComHumansNetMapboxSdkGoMapbox1(in *jlexer.Lexer
 					out.Features = (out.Features)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 Feature
-					(v7).UnmarshalEasyJSON(in)
-					out.Features = append(out.Features, v7)
+					var v4 Feature
+					(v4).UnmarshalEasyJSON(in)
+					out.Features = append(out.Features, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -212,9 +182,9 @@ func easyjson46e924aeDecodeGithubComHumansNetMapboxSdkGoMapbox1(in *jlexer.Lexer
 					out.Query = (out.Query)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v8 string
-					v8 = string(in.String())
-					out.Query = append(out.Query, v8)
+					var v5 string
+					v5 = string(in.String())
+					out.Query = append(out.Query, v5)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -240,11 +210,11 @@ func easyjson46e924aeEncodeGithubComHumansNetMapboxSdkGoMapbox1(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v9, v10 := range in.Features {
-				if v9 > 0 {
+			for v6, v7 := range in.Features {
+				if v6 > 0 {
 					out.RawByte(',')
 				}
-				(v10).MarshalEasyJSON(out)
+				(v7).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -256,11 +226,11 @@ func easyjson46e924aeEncodeGithubComHumansNetMapboxSdkGoMapbox1(out *jwriter.Wri
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Query {
-				if v11 > 0 {
+			for v8, v9 := range in.Query {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v12))
+				out.String(string(v9))
 			}
 			out.RawByte(']')
 		}
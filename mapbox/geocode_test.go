@@ -2,6 +2,8 @@ package mapbox
 
 import (
 	"context"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/valyala/fasthttp"
@@ -24,4 +26,222 @@ func Benchmark_Geocoder(b *testing.B) {
 	}
 }
 
+func Benchmark_GeocoderReuseContexts(b *testing.B) {
+	g := NewFastHttpGeocoder(HttpClient(&fastHttpClient{}), ReuseContexts(true))
+	for i := 0; i <= b.N; i++ {
+		resp1, _ = g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{})
+		resp1.Release()
+	}
+}
+
+func TestReuseContexts(t *testing.T) {
+	client := HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		if strings.Contains(string(req.RequestURI()), "washington") {
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["washington"],"features":[{"id":"place.1","context":[{"id":"country.1","text":"United States"}]}]}`)
+			return nil
+		}
+		resp.SetBody(testRespBody)
+		return nil
+	}))
+
+	want, err := NewFastHttpGeocoder(client).ReverseGeocode(context.Background(), &ReverseGeocodeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewFastHttpGeocoder(client, ReuseContexts(true))
+	for i := 0; i < 3; i++ {
+		got, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got.Features, want.Features) || !reflect.DeepEqual(got.ReverseQuery, want.ReverseQuery) {
+			t.Fatalf("pooled decode differs %+v, want %+v", got.Features, want.Features)
+		}
+		got.Release()
+		if got.Features[0].Context != nil {
+			t.Fatal("contexts expected to be released")
+		}
+	}
+
+	forward, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "washington"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forward.Query.Text != "washington" || len(forward.Features) != 1 || len(forward.Features[0].Context) != 1 {
+		t.Errorf("unexpected forward resp %+v", forward)
+	}
+}
+
+func TestFastHttpGeocoder_Query(t *testing.T) {
+	g := NewFastHttpGeocoder(HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		if strings.Contains(string(req.RequestURI()), "washington") {
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["washington","dc"],"features":[]}`)
+			return nil
+		}
+		resp.SetBody(testRespBody)
+		return nil
+	})))
+
+	reverse, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reverse.Query.Point == nil || *reverse.Query.Point != (GeoPoint{Lon: -77.05, Lat: 38.889}) || reverse.Query.Text != "" {
+		t.Errorf("unexpected reverse query %+v", reverse.Query)
+	}
+
+	forward, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "washington dc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forward.Query.Point != nil || forward.Query.Text != "washington dc" || len(forward.Query.Tokens) != 2 {
+		t.Errorf("unexpected forward query %+v", forward.Query)
+	}
+}
+
+func TestOmitDefaultParams(t *testing.T) {
+	var query string
+	client := HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		query = string(req.URI().QueryString())
+		resp.SetBodyString(`{"type":"FeatureCollection","query":["a"],"features":[]}`)
+		return nil
+	}))
+
+	on, off := true, false
+
+	tests := []struct {
+		name string
+		omit bool
+		req  ForwardGeocodeRequest
+		want string
+	}{
+		{name: "defaults sent", req: ForwardGeocodeRequest{SearchText: "a"}, want: "access_token=&autocomplete=true&fuzzymatch=true&routing=false"},
+		{name: "defaults omitted", omit: true, req: ForwardGeocodeRequest{SearchText: "a", Autocomplete: &on}, want: "access_token="},
+		{
+			name: "non defaults kept",
+			omit: true,
+			req:  ForwardGeocodeRequest{SearchText: "a", Autocomplete: &off, FuzzyMatch: &off, Routing: true},
+			want: "access_token=&autocomplete=false&fuzzymatch=false&routing=true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewFastHttpGeocoder(client, AccessToken(""), OmitDefaultParams(tt.omit))
+			if _, err := g.ForwardGeocode(context.Background(), &tt.req); err != nil {
+				t.Fatal(err)
+			}
+			if query != tt.want {
+				t.Errorf("query = %s, want %s", query, tt.want)
+			}
+		})
+	}
+}
+
 var testRespBody = []byte(`{"type":"FeatureCollection","query":[-77.05,38.889],"features":[{"id":"address.6707678235122794","type":"Feature","place_type":["address"],"relevance":1,"properties":{"accuracy":"rooftop"},"text":"Lincoln Memorial Circle SW","place_name":"2 Lincoln Memorial Circle SW, Washington, District of Columbia 20024, United States","center":[-77.0501629,38.8892227],"geometry":{"type":"Point","coordinates":[-77.0501629,38.8892227]},"address":"2","context":[{"id":"neighborhood.295198","text":"National Mall"},{"id":"postcode.4419139247733840","text":"20024"},{"id":"place.7673410831246050","wikidata":"Q61","text":"Washington"},{"id":"region.1753213251667470","short_code":"US-DC","wikidata":"Q3551781","text":"District of Columbia"},{"id":"country.9053006287256050","short_code":"us","wikidata":"Q30","text":"United States"}]},{"id":"neighborhood.295198","type":"Feature","place_type":["neighborhood"],"relevance":1,"properties":{},"text":"National Mall","place_name":"National Mall, Washington, District of Columbia 20024, United States","bbox":[-77.056852,38.8788473,-77.0140495,38.893034],"center":[-77.02,38.89],"geometry":{"type":"Point","coordinates":[-77.02,38.89]},"context":[{"id":"postcode.4419139247733840","text":"20024"},{"id":"place.7673410831246050","wikidata":"Q61","text":"Washington"},{"id":"region.1753213251667470","short_code":"US-DC","wikidata":"Q3551781","text":"District of Columbia"},{"id":"country.9053006287256050","short_code":"us","wikidata":"Q30","text":"United States"}]},{"id":"postcode.4419139247733840","type":"Feature","place_type":["postcode"],"relevance":1,"properties":{},"text":"20024","place_name":"Washington, District of Columbia 20024, United States","bbox":[-77.0644108917888,38.8501751868964,-77.0036921626302,38.8928826270284],"center":[-77.03,38.89],"geometry":{"type":"Point","coordinates":[-77.03,38.89]},"context":[{"id":"place.7673410831246050","wikidata":"Q61","text":"Washington"},{"id":"region.1753213251667470","short_code":"US-DC","wikidata":"Q3551781","text":"District of Columbia"},{"id":"country.9053006287256050","short_code":"us","wikidata":"Q30","text":"United States"}]},{"id":"place.7673410831246050","type":"Feature","place_type":["place"],"relevance":1,"properties":{"wikidata":"Q61"},"text":"Washington","place_name":"Washington, District of Columbia, United States","bbox":[-77.1197609567342,38.79155738,-76.909391,38.99555093],"center":[-77.0366,38.895],"geometry":{"type":"Point","coordinates":[-77.0366,38.895]},"context":[{"id":"region.1753213251667470","short_code":"US-DC","wikidata":"Q3551781","text":"District of Columbia"},{"id":"country.9053006287256050","short_code":"us","wikidata":"Q30","text":"United States"}]},{"id":"region.1753213251667470","type":"Feature","place_type":["region"],"relevance":1,"properties":{"short_code":"US-DC","wikidata":"Q3551781"},"text":"District of Columbia","place_name":"District of Columbia, United States","bbox":[-77.208138,38.717703,-76.909393,38.995548],"center":[-77.03667,38.895],"geometry":{"type":"Point","coordinates":[-77.03667,38.895]},"context":[{"id":"country.9053006287256050","short_code":"us","wikidata":"Q30","text":"United States"}]},{"id":"country.9053006287256050","type":"Feature","place_type":["country"],"relevance":1,"properties":{"short_code":"us","wikidata":"Q30"},"text":"United States","place_name":"United States","bbox":[-179.9,18.765563,-66.885444,71.540724],"center":[-100,40],"geometry":{"type":"Point","coordinates":[-100,40]}}],"attribution":"NOTICE: © 2020 Mapbox and its suppliers. All rights reserved. Use of this data is subject to the Mapbox Terms of Service (https://www.mapbox.com/about/maps/). This response and the information it contains may not be retained. POI(s) provided by Foursquare."}`)
+
+func TestFastHttpGeocoder_ForwardGeocodeEscaping(t *testing.T) {
+	var uri string
+	g := NewFastHttpGeocoder(AccessToken("token"), OmitDefaultParams(true), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["a"],"features":[]}`)
+			return nil
+		})))
+
+	if _, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "1 Main St #2&limit=9/Zürich"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "/mapbox.places/1%20Main%20St%20%232%26limit%3D9%2FZ%C3%BCrich.json?access_token=token"
+	if !strings.HasSuffix(uri, want) {
+		t.Errorf("uri = %s, want suffix %s", uri, want)
+	}
+}
+
+func Test_queryGeoPoint(t *testing.T) {
+	tests := []struct {
+		raw    string
+		want   GeoPoint
+		wantOK bool
+	}{
+		{raw: `[-77.05,38.889]`, want: GeoPoint{Lon: -77.05, Lat: 38.889}, wantOK: true},
+		{raw: `[-77,38]`, want: GeoPoint{Lon: -77, Lat: 38}, wantOK: true},
+		{raw: `[-77.05,38.889,12]`, want: GeoPoint{Lon: -77.05, Lat: 38.889}, wantOK: true},
+		{raw: `["-77.05","38.889"]`, want: GeoPoint{Lon: -77.05, Lat: 38.889}, wantOK: true},
+		{raw: `[-77.05]`},
+		{raw: `["washington","dc"]`},
+		{raw: `null`},
+	}
+	for _, tt := range tests {
+		got, ok := queryGeoPoint([]byte(tt.raw))
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("queryGeoPoint(%s) = %v, %v, want %v, %v", tt.raw, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestFastHttpGeocoder_ReverseGeocodeUnexpectedQuery(t *testing.T) {
+	g := NewFastHttpGeocoder(HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		resp.SetBodyString(`{"type":"FeatureCollection","query":[-77.05],"features":[{"id":"place.1"}]}`)
+		return nil
+	})))
+
+	resp, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Query.Point != nil || string(resp.Query.Raw) != "[-77.05]" || len(resp.Features) != 1 {
+		t.Errorf("unexpected resp %+v", resp)
+	}
+}
+
+func TestFastHttpGeocoder_Worldview(t *testing.T) {
+	var queries []string
+	g := NewFastHttpGeocoder(AccessToken("token"), OmitDefaultParams(true), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			queries = append(queries, string(req.URI().QueryString()))
+			if strings.Contains(string(req.RequestURI()), "kashmir") {
+				resp.SetBodyString(`{"type":"FeatureCollection","query":["kashmir"],"features":[]}`)
+				return nil
+			}
+			resp.SetBody(testRespBody)
+			return nil
+		})))
+
+	if _, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{Worldview: "in"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "kashmir", Worldview: "cn"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"access_token=token&worldview=in", "access_token=token&worldview=cn"}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("queries = %v, want %v", queries, want)
+	}
+}
+
+func TestGeocodeEndpoint_Unknown(t *testing.T) {
+	called := false
+	g := NewFastHttpGeocoder(GeocodeEndpoint("mapbox.place"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			called = true
+			return nil
+		})))
+
+	if err := g.Validate(); err == nil || !strings.Contains(err.Error(), `unknown geocode endpoint "mapbox.place"`) {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if err := NewFastHttpGeocoder(GeocodeEndpoint(PlacesPermanent)).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+
+	_, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "a"})
+	if err == nil || !strings.Contains(err.Error(), `unknown geocode endpoint "mapbox.place"`) {
+		t.Errorf("unexpected error %v", err)
+	}
+	if called {
+		t.Error("request must not be sent")
+	}
+}
@@ -15,14 +15,14 @@ import (
 type GeocoderMock struct {
 	t minimock.Tester
 
-	funcForwardGeocode          func(ctx context.Context, req *ForwardGeocodeRequest) (gp1 *GeocodeResponse, err error)
-	inspectFuncForwardGeocode   func(ctx context.Context, req *ForwardGeocodeRequest)
+	funcForwardGeocode          func(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption) (gp1 *GeocodeResponse, err error)
+	inspectFuncForwardGeocode   func(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption)
 	afterForwardGeocodeCounter  uint64
 	beforeForwardGeocodeCounter uint64
 	ForwardGeocodeMock          mGeocoderMockForwardGeocode
 
-	funcReverseGeocode          func(ctx context.Context, req *ReverseGeocodeRequest) (gp1 *GeocodeResponse, err error)
-	inspectFuncReverseGeocode   func(ctx context.Context, req *ReverseGeocodeRequest)
+	funcReverseGeocode          func(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption) (gp1 *GeocodeResponse, err error)
+	inspectFuncReverseGeocode   func(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption)
 	afterReverseGeocodeCounter  uint64
 	beforeReverseGeocodeCounter uint64
 	ReverseGeocodeMock          mGeocoderMockReverseGeocode
@@ -64,7 +64,8 @@ type GeocoderMockForwardGeocodeExpectation struct {
 // GeocoderMockForwardGeocodeParams contains parameters of the Geocoder.ForwardGeocode
 type GeocoderMockForwardGeocodeParams struct {
 	ctx context.Context
-	req *ForwardGeocodeRequest
+	req  *ForwardGeocodeRequest
+	opts []CallOption
 }
 
 // GeocoderMockForwardGeocodeResults contains results of the Geocoder.ForwardGeocode
@@ -74,7 +75,7 @@ type GeocoderMockForwardGeocodeResults struct {
 }
 
 // Expect sets up expected params for Geocoder.ForwardGeocode
-func (mmForwardGeocode *mGeocoderMockForwardGeocode) Expect(ctx context.Context, req *ForwardGeocodeRequest) *mGeocoderMockForwardGeocode {
+func (mmForwardGeocode *mGeocoderMockForwardGeocode) Expect(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption) *mGeocoderMockForwardGeocode {
 	if mmForwardGeocode.mock.funcForwardGeocode != nil {
 		mmForwardGeocode.mock.t.Fatalf("GeocoderMock.ForwardGeocode mock is already set by Set")
 	}
@@ -83,7 +84,7 @@ func (mmForwardGeocode *mGeocoderMockForwardGeocode) Expect(ctx context.Context,
 		mmForwardGeocode.defaultExpectation = &GeocoderMockForwardGeocodeExpectation{}
 	}
 
-	mmForwardGeocode.defaultExpectation.params = &GeocoderMockForwardGeocodeParams{ctx, req}
+	mmForwardGeocode.defaultExpectation.params = &GeocoderMockForwardGeocodeParams{ctx, req, opts}
 	for _, e := range mmForwardGeocode.expectations {
 		if minimock.Equal(e.params, mmForwardGeocode.defaultExpectation.params) {
 			mmForwardGeocode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmForwardGeocode.defaultExpectation.params)
@@ -94,7 +95,7 @@ func (mmForwardGeocode *mGeocoderMockForwardGeocode) Expect(ctx context.Context,
 }
 
 // Inspect accepts an inspector function that has same arguments as the Geocoder.ForwardGeocode
-func (mmForwardGeocode *mGeocoderMockForwardGeocode) Inspect(f func(ctx context.Context, req *ForwardGeocodeRequest)) *mGeocoderMockForwardGeocode {
+func (mmForwardGeocode *mGeocoderMockForwardGeocode) Inspect(f func(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption)) *mGeocoderMockForwardGeocode {
 	if mmForwardGeocode.mock.inspectFuncForwardGeocode != nil {
 		mmForwardGeocode.mock.t.Fatalf("Inspect function is already set for GeocoderMock.ForwardGeocode")
 	}
@@ -118,7 +119,7 @@ func (mmForwardGeocode *mGeocoderMockForwardGeocode) Return(gp1 *GeocodeResponse
 }
 
 //Set uses given function f to mock the Geocoder.ForwardGeocode method
-func (mmForwardGeocode *mGeocoderMockForwardGeocode) Set(f func(ctx context.Context, req *ForwardGeocodeRequest) (gp1 *GeocodeResponse, err error)) *GeocoderMock {
+func (mmForwardGeocode *mGeocoderMockForwardGeocode) Set(f func(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption) (gp1 *GeocodeResponse, err error)) *GeocoderMock {
 	if mmForwardGeocode.defaultExpectation != nil {
 		mmForwardGeocode.mock.t.Fatalf("Default expectation is already set for the Geocoder.ForwardGeocode method")
 	}
@@ -133,14 +134,14 @@ func (mmForwardGeocode *mGeocoderMockForwardGeocode) Set(f func(ctx context.Cont
 
 // When sets expectation for the Geocoder.ForwardGeocode which will trigger the result defined by the following
 // Then helper
-func (mmForwardGeocode *mGeocoderMockForwardGeocode) When(ctx context.Context, req *ForwardGeocodeRequest) *GeocoderMockForwardGeocodeExpectation {
+func (mmForwardGeocode *mGeocoderMockForwardGeocode) When(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption) *GeocoderMockForwardGeocodeExpectation {
 	if mmForwardGeocode.mock.funcForwardGeocode != nil {
 		mmForwardGeocode.mock.t.Fatalf("GeocoderMock.ForwardGeocode mock is already set by Set")
 	}
 
 	expectation := &GeocoderMockForwardGeocodeExpectation{
 		mock:   mmForwardGeocode.mock,
-		params: &GeocoderMockForwardGeocodeParams{ctx, req},
+		params: &GeocoderMockForwardGeocodeParams{ctx, req, opts},
 	}
 	mmForwardGeocode.expectations = append(mmForwardGeocode.expectations, expectation)
 	return expectation
@@ -153,15 +154,15 @@ func (e *GeocoderMockForwardGeocodeExpectation) Then(gp1 *GeocodeResponse, err e
 }
 
 // ForwardGeocode implements Geocoder
-func (mmForwardGeocode *GeocoderMock) ForwardGeocode(ctx context.Context, req *ForwardGeocodeRequest) (gp1 *GeocodeResponse, err error) {
+func (mmForwardGeocode *GeocoderMock) ForwardGeocode(ctx context.Context, req *ForwardGeocodeRequest, opts ...CallOption) (gp1 *GeocodeResponse, err error) {
 	mm_atomic.AddUint64(&mmForwardGeocode.beforeForwardGeocodeCounter, 1)
 	defer mm_atomic.AddUint64(&mmForwardGeocode.afterForwardGeocodeCounter, 1)
 
 	if mmForwardGeocode.inspectFuncForwardGeocode != nil {
-		mmForwardGeocode.inspectFuncForwardGeocode(ctx, req)
+		mmForwardGeocode.inspectFuncForwardGeocode(ctx, req, opts...)
 	}
 
-	mm_params := &GeocoderMockForwardGeocodeParams{ctx, req}
+	mm_params := &GeocoderMockForwardGeocodeParams{ctx, req, opts}
 
 	// Record call args
 	mmForwardGeocode.ForwardGeocodeMock.mutex.Lock()
@@ -178,7 +179,7 @@ func (mmForwardGeocode *GeocoderMock) ForwardGeocode(ctx context.Context, req *F
 	if mmForwardGeocode.ForwardGeocodeMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmForwardGeocode.ForwardGeocodeMock.defaultExpectation.Counter, 1)
 		mm_want := mmForwardGeocode.ForwardGeocodeMock.defaultExpectation.params
-		mm_got := GeocoderMockForwardGeocodeParams{ctx, req}
+		mm_got := GeocoderMockForwardGeocodeParams{ctx, req, opts}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmForwardGeocode.t.Errorf("GeocoderMock.ForwardGeocode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -190,9 +191,9 @@ func (mmForwardGeocode *GeocoderMock) ForwardGeocode(ctx context.Context, req *F
 		return (*mm_results).gp1, (*mm_results).err
 	}
 	if mmForwardGeocode.funcForwardGeocode != nil {
-		return mmForwardGeocode.funcForwardGeocode(ctx, req)
+		return mmForwardGeocode.funcForwardGeocode(ctx, req, opts...)
 	}
-	mmForwardGeocode.t.Fatalf("Unexpected call to GeocoderMock.ForwardGeocode. %v %v", ctx, req)
+	mmForwardGeocode.t.Fatalf("Unexpected call to GeocoderMock.ForwardGeocode. %v %v %v", ctx, req, opts)
 	return
 }
 
@@ -281,7 +282,8 @@ type GeocoderMockReverseGeocodeExpectation struct {
 // GeocoderMockReverseGeocodeParams contains parameters of the Geocoder.ReverseGeocode
 type GeocoderMockReverseGeocodeParams struct {
 	ctx context.Context
-	req *ReverseGeocodeRequest
+	req  *ReverseGeocodeRequest
+	opts []CallOption
 }
 
 // GeocoderMockReverseGeocodeResults contains results of the Geocoder.ReverseGeocode
@@ -291,7 +293,7 @@ type GeocoderMockReverseGeocodeResults struct {
 }
 
 // Expect sets up expected params for Geocoder.ReverseGeocode
-func (mmReverseGeocode *mGeocoderMockReverseGeocode) Expect(ctx context.Context, req *ReverseGeocodeRequest) *mGeocoderMockReverseGeocode {
+func (mmReverseGeocode *mGeocoderMockReverseGeocode) Expect(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption) *mGeocoderMockReverseGeocode {
 	if mmReverseGeocode.mock.funcReverseGeocode != nil {
 		mmReverseGeocode.mock.t.Fatalf("GeocoderMock.ReverseGeocode mock is already set by Set")
 	}
@@ -300,7 +302,7 @@ func (mmReverseGeocode *mGeocoderMockReverseGeocode) Expect(ctx context.Context,
 		mmReverseGeocode.defaultExpectation = &GeocoderMockReverseGeocodeExpectation{}
 	}
 
-	mmReverseGeocode.defaultExpectation.params = &GeocoderMockReverseGeocodeParams{ctx, req}
+	mmReverseGeocode.defaultExpectation.params = &GeocoderMockReverseGeocodeParams{ctx, req, opts}
 	for _, e := range mmReverseGeocode.expectations {
 		if minimock.Equal(e.params, mmReverseGeocode.defaultExpectation.params) {
 			mmReverseGeocode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReverseGeocode.defaultExpectation.params)
@@ -311,7 +313,7 @@ func (mmReverseGeocode *mGeocoderMockReverseGeocode) Expect(ctx context.Context,
 }
 
 // Inspect accepts an inspector function that has same arguments as the Geocoder.ReverseGeocode
-func (mmReverseGeocode *mGeocoderMockReverseGeocode) Inspect(f func(ctx context.Context, req *ReverseGeocodeRequest)) *mGeocoderMockReverseGeocode {
+func (mmReverseGeocode *mGeocoderMockReverseGeocode) Inspect(f func(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption)) *mGeocoderMockReverseGeocode {
 	if mmReverseGeocode.mock.inspectFuncReverseGeocode != nil {
 		mmReverseGeocode.mock.t.Fatalf("Inspect function is already set for GeocoderMock.ReverseGeocode")
 	}
@@ -335,7 +337,7 @@ func (mmReverseGeocode *mGeocoderMockReverseGeocode) Return(gp1 *GeocodeResponse
 }
 
 //Set uses given function f to mock the Geocoder.ReverseGeocode method
-func (mmReverseGeocode *mGeocoderMockReverseGeocode) Set(f func(ctx context.Context, req *ReverseGeocodeRequest) (gp1 *GeocodeResponse, err error)) *GeocoderMock {
+func (mmReverseGeocode *mGeocoderMockReverseGeocode) Set(f func(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption) (gp1 *GeocodeResponse, err error)) *GeocoderMock {
 	if mmReverseGeocode.defaultExpectation != nil {
 		mmReverseGeocode.mock.t.Fatalf("Default expectation is already set for the Geocoder.ReverseGeocode method")
 	}
@@ -350,14 +352,14 @@ func (mmReverseGeocode *mGeocoderMockReverseGeocode) Set(f func(ctx context.Cont
 
 // When sets expectation for the Geocoder.ReverseGeocode which will trigger the result defined by the following
 // Then helper
-func (mmReverseGeocode *mGeocoderMockReverseGeocode) When(ctx context.Context, req *ReverseGeocodeRequest) *GeocoderMockReverseGeocodeExpectation {
+func (mmReverseGeocode *mGeocoderMockReverseGeocode) When(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption) *GeocoderMockReverseGeocodeExpectation {
 	if mmReverseGeocode.mock.funcReverseGeocode != nil {
 		mmReverseGeocode.mock.t.Fatalf("GeocoderMock.ReverseGeocode mock is already set by Set")
 	}
 
 	expectation := &GeocoderMockReverseGeocodeExpectation{
 		mock:   mmReverseGeocode.mock,
-		params: &GeocoderMockReverseGeocodeParams{ctx, req},
+		params: &GeocoderMockReverseGeocodeParams{ctx, req, opts},
 	}
 	mmReverseGeocode.expectations = append(mmReverseGeocode.expectations, expectation)
 	return expectation
@@ -370,15 +372,15 @@ func (e *GeocoderMockReverseGeocodeExpectation) Then(gp1 *GeocodeResponse, err e
 }
 
 // ReverseGeocode implements Geocoder
-func (mmReverseGeocode *GeocoderMock) ReverseGeocode(ctx context.Context, req *ReverseGeocodeRequest) (gp1 *GeocodeResponse, err error) {
+func (mmReverseGeocode *GeocoderMock) ReverseGeocode(ctx context.Context, req *ReverseGeocodeRequest, opts ...CallOption) (gp1 *GeocodeResponse, err error) {
 	mm_atomic.AddUint64(&mmReverseGeocode.beforeReverseGeocodeCounter, 1)
 	defer mm_atomic.AddUint64(&mmReverseGeocode.afterReverseGeocodeCounter, 1)
 
 	if mmReverseGeocode.inspectFuncReverseGeocode != nil {
-		mmReverseGeocode.inspectFuncReverseGeocode(ctx, req)
+		mmReverseGeocode.inspectFuncReverseGeocode(ctx, req, opts...)
 	}
 
-	mm_params := &GeocoderMockReverseGeocodeParams{ctx, req}
+	mm_params := &GeocoderMockReverseGeocodeParams{ctx, req, opts}
 
 	// Record call args
 	mmReverseGeocode.ReverseGeocodeMock.mutex.Lock()
@@ -395,7 +397,7 @@ func (mmReverseGeocode *GeocoderMock) ReverseGeocode(ctx context.Context, req *R
 	if mmReverseGeocode.ReverseGeocodeMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmReverseGeocode.ReverseGeocodeMock.defaultExpectation.Counter, 1)
 		mm_want := mmReverseGeocode.ReverseGeocodeMock.defaultExpectation.params
-		mm_got := GeocoderMockReverseGeocodeParams{ctx, req}
+		mm_got := GeocoderMockReverseGeocodeParams{ctx, req, opts}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmReverseGeocode.t.Errorf("GeocoderMock.ReverseGeocode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -407,9 +409,9 @@ func (mmReverseGeocode *GeocoderMock) ReverseGeocode(ctx context.Context, req *R
 		return (*mm_results).gp1, (*mm_results).err
 	}
 	if mmReverseGeocode.funcReverseGeocode != nil {
-		return mmReverseGeocode.funcReverseGeocode(ctx, req)
+		return mmReverseGeocode.funcReverseGeocode(ctx, req, opts...)
 	}
-	mmReverseGeocode.t.Fatalf("Unexpected call to GeocoderMock.ReverseGeocode. %v %v", ctx, req)
+	mmReverseGeocode.t.Fatalf("Unexpected call to GeocoderMock.ReverseGeocode. %v %v %v", ctx, req, opts)
 	return
 }
 
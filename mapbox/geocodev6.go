@@ -0,0 +1,505 @@
+package mapbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	permanent = "permanent"
+	longitude = "longitude"
+	latitude  = "latitude"
+)
+
+// MaxBatchGeocodeQueries is the maximum number of queries in a single v6 batch request.
+const MaxBatchGeocodeQueries = 1000
+
+var queryParamV6 = []byte("&q=")
+
+// ForwardGeocodeV6Request describes geocode/v6 forward request.
+type ForwardGeocodeV6Request struct {
+	// Query is a free form search text, e.g. an address or a place name.
+	Query string
+	// Country limits results to ISO 3166 alpha 2 country codes separated by commas.
+	Country string
+	// Language of the returned text, default to language set with WithContextLanguage.
+	Language string
+	// Limit is the maximum number of results, default 5 and max 10.
+	Limit int
+	// Types filters feature types, e.g. address, street, postcode or place.
+	Types []string
+	// Proximity biases results to the point.
+	Proximity *GeoPoint
+	// Bbox limits results to minLon,minLat,maxLon,maxLat box.
+	Bbox []float64
+	// Autocomplete returns partial matches, default true.
+	Autocomplete *bool
+	// Permanent overrides Permanent option.
+	Permanent *bool
+}
+
+// V6 converts v5 forward request to a v6 one, e.g. to geocode it with BatchGeocode.
+// FuzzyMatch, Routing, Worldview, Thresholds, BiasProfile and TypesPreset have no v6 counterparts and are dropped,
+// Permanent option of v5 client should be set on v6 one.
+func (req *ForwardGeocodeRequest) V6() ForwardGeocodeV6Request {
+	return ForwardGeocodeV6Request{
+		Query:        req.SearchText,
+		Country:      req.Country,
+		Language:     req.Language,
+		Limit:        req.Limit,
+		Types:        req.Types,
+		Proximity:    req.Proximity,
+		Bbox:         req.Bbox,
+		Autocomplete: req.Autocomplete,
+	}
+}
+
+// ReverseGeocodeV6Request describes geocode/v6 reverse request.
+type ReverseGeocodeV6Request struct {
+	GeoPoint GeoPoint
+	// Country limits results to ISO 3166 alpha 2 country codes separated by commas.
+	Country string
+	// Language of the returned text, default to language set with WithContextLanguage.
+	Language string
+	// Limit is the maximum number of results, it requires a single type in Types.
+	Limit int
+	// Types filters feature types, e.g. address, street, postcode or place.
+	Types []string
+	// Permanent overrides Permanent option.
+	Permanent *bool
+}
+
+// FeatureV6 is a geocode/v6 feature, unlike v5 all the data is kept in properties.
+type FeatureV6 struct {
+	ID         string       `json:"id"`
+	Type       string       `json:"type"`
+	Geometry   Geometry     `json:"geometry"`
+	Properties PropertiesV6 `json:"properties"`
+}
+
+// PropertiesV6 holds geocode/v6 feature data.
+type PropertiesV6 struct {
+	MapboxID       string        `json:"mapbox_id"`
+	FeatureType    string        `json:"feature_type"`
+	Name           string        `json:"name"`
+	NamePreferred  string        `json:"name_preferred,omitempty"`
+	PlaceFormatted string        `json:"place_formatted,omitempty"`
+	FullAddress    string        `json:"full_address,omitempty"`
+	Coordinates    CoordinatesV6 `json:"coordinates"`
+	Context        ContextV6     `json:"context"`
+	BoundingBox    []float64     `json:"bbox,omitempty"`
+	// MatchCode is set for forward geocoded addresses only.
+	MatchCode *MatchCode `json:"match_code,omitempty"`
+}
+
+// CoordinatesV6 is a feature point with its accuracy.
+type CoordinatesV6 struct {
+	Longitude      float64           `json:"longitude"`
+	Latitude       float64           `json:"latitude"`
+	Accuracy       Accuracy          `json:"accuracy,omitempty"`
+	RoutablePoints []RoutablePointV6 `json:"routable_points,omitempty"`
+}
+
+// GeoPoint returns coordinates as GeoPoint.
+func (c CoordinatesV6) GeoPoint() GeoPoint {
+	return GeoPoint{Lon: c.Longitude, Lat: c.Latitude}
+}
+
+// RoutablePointV6 is a point on the road the feature fronts.
+type RoutablePointV6 struct {
+	Name      string  `json:"name"`
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+}
+
+// ContextV6 is a feature hierarchy keyed by feature type, items are nil if absent.
+type ContextV6 struct {
+	Country      *CountryContextV6 `json:"country,omitempty"`
+	Region       *RegionContextV6  `json:"region,omitempty"`
+	Postcode     *ContextItemV6    `json:"postcode,omitempty"`
+	District     *ContextItemV6    `json:"district,omitempty"`
+	Place        *ContextItemV6    `json:"place,omitempty"`
+	Locality     *ContextItemV6    `json:"locality,omitempty"`
+	Neighborhood *ContextItemV6    `json:"neighborhood,omitempty"`
+	Street       *ContextItemV6    `json:"street,omitempty"`
+	Address      *AddressContextV6 `json:"address,omitempty"`
+}
+
+// ContextItemV6 is a single feature hierarchy item.
+type ContextItemV6 struct {
+	MapboxID   string `json:"mapbox_id"`
+	Name       string `json:"name"`
+	WikidataID string `json:"wikidata_id,omitempty"`
+}
+
+// CountryContextV6 is a country of the feature.
+type CountryContextV6 struct {
+	ContextItemV6
+	CountryCode       string `json:"country_code"`
+	CountryCodeAlpha3 string `json:"country_code_alpha_3"`
+}
+
+// RegionContextV6 is a region of the feature, e.g. a state.
+type RegionContextV6 struct {
+	ContextItemV6
+	RegionCode     string `json:"region_code"`
+	RegionCodeFull string `json:"region_code_full"`
+}
+
+// AddressContextV6 is an address of the feature.
+type AddressContextV6 struct {
+	ContextItemV6
+	AddressNumber string `json:"address_number"`
+	StreetName    string `json:"street_name"`
+}
+
+// MatchCode describes how address query parts matched the feature,
+// values are matched, unmatched, plausible or not_applicable.
+type MatchCode struct {
+	AddressNumber string `json:"address_number,omitempty"`
+	Street        string `json:"street,omitempty"`
+	Postcode      string `json:"postcode,omitempty"`
+	Place         string `json:"place,omitempty"`
+	Region        string `json:"region,omitempty"`
+	Locality      string `json:"locality,omitempty"`
+	Country       string `json:"country,omitempty"`
+	// Confidence is exact, high, medium or low.
+	Confidence string `json:"confidence,omitempty"`
+}
+
+// easyjson:json
+type rawGeocodeV6Resp struct {
+	Type        string      `json:"type"`
+	Features    []FeatureV6 `json:"features"`
+	Attribution string      `json:"attribution"`
+}
+
+// batchQueryV6 is a single query of v6 batch request.
+type batchQueryV6 struct {
+	Q            string    `json:"q"`
+	Country      string    `json:"country,omitempty"`
+	Language     string    `json:"language,omitempty"`
+	Limit        int       `json:"limit,omitempty"`
+	Types        []string  `json:"types,omitempty"`
+	Proximity    []float64 `json:"proximity,omitempty"`
+	Bbox         []float64 `json:"bbox,omitempty"`
+	Autocomplete *bool     `json:"autocomplete,omitempty"`
+}
+
+// easyjson:json
+type rawBatchQueriesV6 []batchQueryV6
+
+// easyjson:json
+type rawBatchGeocodeV6Resp struct {
+	Batch []rawGeocodeV6Resp `json:"batch"`
+}
+
+// BatchGeocodeV6Response wraps v6 batch results.
+type BatchGeocodeV6Response struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	// Results are features of every query in requests order.
+	Results [][]FeatureV6
+	// Permanent results could be stored.
+	Permanent bool
+
+	config *config
+}
+
+// Retain returns raw response to store, see StrictStorage.
+func (r *BatchGeocodeV6Response) Retain() ([]byte, error) {
+	return r.config.retain(r.Permanent, r.RawResp)
+}
+
+// GeocodeV6Response wraps geocode/v6 features.
+type GeocodeV6Response struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Features    []FeatureV6
+	Attribution string
+	// Permanent results could be stored.
+	Permanent bool
+
+	config *config
+}
+
+// Retain returns raw response to store, see StrictStorage.
+func (r *GeocodeV6Response) Retain() ([]byte, error) {
+	return r.config.retain(r.Permanent, r.RawResp)
+}
+
+// GeocoderV6 encapsulates geocode/v6 forward and reverse calls.
+type GeocoderV6 interface {
+	// ForwardGeocodeV6 calls search/geocode/v6 forward mapbox API
+	ForwardGeocodeV6(ctx context.Context, req *ForwardGeocodeV6Request, opts ...CallOption) (*GeocodeV6Response, error)
+	// ReverseGeocodeV6 calls search/geocode/v6 reverse mapbox API
+	ReverseGeocodeV6(ctx context.Context, req *ReverseGeocodeV6Request, opts ...CallOption) (*GeocodeV6Response, error)
+	// BatchGeocode calls search/geocode/v6 batch mapbox API with up to MaxBatchGeocodeQueries forward queries
+	BatchGeocode(ctx context.Context, reqs []ForwardGeocodeV6Request, opts ...CallOption) (*BatchGeocodeV6Response, error)
+}
+
+// FastHttpGeocoderV6 is a fasthttp GeocoderV6 implementation
+type FastHttpGeocoderV6 struct {
+	config
+
+	forwardAPIURL []byte
+	reverseAPIURL []byte
+	batchAPIURL   []byte
+
+	stringBufPull *stringsBufferPool
+}
+
+// ForwardGeocodeV6 calls search/geocode/v6 forward mapbox API thought fasthttp client.
+func (c *FastHttpGeocoderV6) ForwardGeocodeV6(ctx context.Context, req *ForwardGeocodeV6Request, opts ...CallOption) (*GeocodeV6Response, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if req.Query == "" {
+		return nil, validationErrorf("Query", ConstraintRequired, "query is required")
+	}
+
+	values := make(map[string]string, 8)
+	if req.Country != "" {
+		values[country] = req.Country
+	}
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
+	}
+	if req.Limit != 0 {
+		values[limit] = strconv.Itoa(req.Limit)
+	}
+	if len(req.Types) > 0 {
+		values[types] = strings.Join(req.Types, ",")
+	}
+	if req.Proximity != nil {
+		values[proximity] = fmt.Sprintf("%f,%f", req.Proximity.Lon, req.Proximity.Lat)
+	}
+	if len(req.Bbox) == 4 {
+		values[bbox] = fmt.Sprintf("%f,%f,%f,%f", req.Bbox[0], req.Bbox[1], req.Bbox[2], req.Bbox[3])
+	}
+	if req.Autocomplete != nil {
+		values[autocomplete] = fmt.Sprint(*req.Autocomplete)
+	}
+	isPermanent := c.requestPermanent(req.Permanent)
+	if isPermanent {
+		values[permanent] = trueStr
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	buf.Write(c.forwardAPIURL)
+	buf.Write(c.accessTokenGetValue)
+	buf.Write(queryParamV6)
+	writePathEscaped(buf, req.Query)
+
+	encodeValues(buf, values)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "forward geocode v6", reqURI, values, logKeySearchTextLen, strconv.Itoa(len(req.Query)))
+
+	return c.geocodeV6(ctx, "forward geocode v6", reqURI, isPermanent)
+}
+
+// ReverseGeocodeV6 calls search/geocode/v6 reverse mapbox API thought fasthttp client.
+func (c *FastHttpGeocoderV6) ReverseGeocodeV6(ctx context.Context, req *ReverseGeocodeV6Request, opts ...CallOption) (*GeocodeV6Response, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	values := make(map[string]string, 7)
+	values[longitude] = strconv.FormatFloat(req.GeoPoint.Lon, floatFormatNoExponent, 6, 64)
+	values[latitude] = strconv.FormatFloat(req.GeoPoint.Lat, floatFormatNoExponent, 6, 64)
+	if req.Country != "" {
+		values[country] = req.Country
+	}
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
+	}
+	if req.Limit != 0 {
+		values[limit] = strconv.Itoa(req.Limit)
+	}
+	if len(req.Types) > 0 {
+		values[types] = strings.Join(req.Types, ",")
+	}
+	isPermanent := c.requestPermanent(req.Permanent)
+	if isPermanent {
+		values[permanent] = trueStr
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	buf.Write(c.reverseAPIURL)
+	buf.Write(c.accessTokenGetValue)
+
+	encodeValues(buf, values)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "reverse geocode v6", reqURI, values,
+		logKeyCoordinate, values[longitude]+string(comma)+values[latitude])
+
+	return c.geocodeV6(ctx, "reverse geocode v6", reqURI, isPermanent)
+}
+
+func (c *FastHttpGeocoderV6) geocodeV6(ctx context.Context, op string, reqURI []byte, isPermanent bool) (*GeocodeV6Response, error) {
+	resp, err := c.do(ctx, op, getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, op, resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError(op, string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawGeocodeV6Resp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall raw %s resp %s: %w", op, string(resp.body), err)
+	}
+
+	return &GeocodeV6Response{
+		RateLimit:   resp.rateLimit,
+		Meta:        resp.meta,
+		RawResp:     resp.body,
+		Features:    respRaw.Features,
+		Attribution: respRaw.Attribution,
+		Permanent:   isPermanent,
+		config:      &c.config,
+	}, nil
+}
+
+// BatchGeocode calls search/geocode/v6 batch mapbox API thought fasthttp client,
+// a single POST replaces up to MaxBatchGeocodeQueries forward requests, v5 ones could be converted with
+// ForwardGeocodeRequest.V6. The batch is permanent as a whole, so requests overriding Permanent option
+// must resolve to the same value, mixed batches are rejected.
+func (c *FastHttpGeocoderV6) BatchGeocode(ctx context.Context, reqs []ForwardGeocodeV6Request, opts ...CallOption) (*BatchGeocodeV6Response, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	return c.batchGeocode(ctx, reqs, 0, 0)
+}
+
+// batchGeocode retries batches rejected with 429 Too Many Requests up to maxRetries times.
+func (c *FastHttpGeocoderV6) batchGeocode(ctx context.Context, reqs []ForwardGeocodeV6Request, maxRetries int,
+	backoff time.Duration) (*BatchGeocodeV6Response, error) {
+	if len(reqs) == 0 {
+		return nil, validationErrorf("", ConstraintMinItems, "batch must have from 1 to %d queries, got %d",
+			MaxBatchGeocodeQueries, len(reqs))
+	}
+	if len(reqs) > MaxBatchGeocodeQueries {
+		return nil, validationErrorf("", ConstraintMaxItems, "batch must have from 1 to %d queries, got %d",
+			MaxBatchGeocodeQueries, len(reqs))
+	}
+
+	values := make(map[string]string, 1)
+	isPermanent := c.requestPermanent(reqs[0].Permanent)
+
+	queries := make(rawBatchQueriesV6, len(reqs))
+	for i := range reqs {
+		req := &reqs[i]
+		if req.Query == "" {
+			return nil, validationErrorf(indexField("", i)+".Query", ConstraintRequired, "query %d is required", i)
+		}
+
+		q := batchQueryV6{
+			Q:            req.Query,
+			Country:      req.Country,
+			Language:     requestLanguage(ctx, req.Language),
+			Limit:        req.Limit,
+			Types:        req.Types,
+			Autocomplete: req.Autocomplete,
+		}
+		if req.Proximity != nil {
+			q.Proximity = []float64{req.Proximity.Lon, req.Proximity.Lat}
+		}
+		if len(req.Bbox) == 4 {
+			q.Bbox = req.Bbox
+		}
+		queries[i] = q
+
+		if c.requestPermanent(req.Permanent) != isPermanent {
+			return nil, validationErrorf(indexField("", i)+".Permanent", ConstraintDepends,
+				"query %d permanent %t conflicts with query 0 permanent %t, batch is stored as a whole",
+				i, !isPermanent, isPermanent)
+		}
+	}
+	if isPermanent {
+		values[permanent] = trueStr
+	}
+
+	body, err := queries.MarshalJSON()
+	if err != nil {
+		return nil, errorf("failed to marshal batch queries: %w", err)
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	buf.Write(c.batchAPIURL)
+	buf.Write(c.accessTokenGetValue)
+
+	encodeValues(buf, values)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "batch geocode v6", reqURI, values, logKeyBatchSize, strconv.Itoa(len(reqs)))
+
+	resp, err := c.retryRateLimited(ctx, maxRetries, backoff, func() (*rawResponse, error) {
+		return c.do(ctx, "batch geocode v6", postMethod, reqURI, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "batch geocode v6", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("batch geocode v6", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawBatchGeocodeV6Resp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall raw batch geocode v6 resp %s: %w", string(resp.body), err)
+	}
+	if len(respRaw.Batch) != len(reqs) {
+		return nil, errorf("unexpected batch geocode v6 results count %d, want %d", len(respRaw.Batch), len(reqs))
+	}
+
+	results := make([][]FeatureV6, len(respRaw.Batch))
+	for i := range respRaw.Batch {
+		results[i] = respRaw.Batch[i].Features
+	}
+
+	return &BatchGeocodeV6Response{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Results:   results,
+		Permanent: isPermanent,
+		config:    &c.config,
+	}, nil
+}
+
+func NewFastHttpGeocoderV6(opts ...Option) *FastHttpGeocoderV6 {
+	c := FastHttpGeocoderV6{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.forwardAPIURL = c.apiURL("/search/geocode/v6/forward")
+	c.reverseAPIURL = c.apiURL("/search/geocode/v6/reverse")
+	c.batchAPIURL = c.apiURL("/search/geocode/v6/batch")
+
+	return &c
+}
@@ -0,0 +1,139 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+const testV6RespBody = `{"type":"FeatureCollection","features":[{"type":"Feature","id":"dXJuOm1ieGFkcjo1","geometry":{"type":"Point","coordinates":[-77.050164,38.889223]},
+"properties":{"mapbox_id":"dXJuOm1ieGFkcjo1","feature_type":"address","full_address":"2 Lincoln Memorial Circle Southwest, Washington, District of Columbia 20024, United States",
+"name":"2 Lincoln Memorial Circle Southwest","place_formatted":"Washington, District of Columbia 20024, United States",
+"coordinates":{"longitude":-77.050164,"latitude":38.889223,"accuracy":"rooftop","routable_points":[{"name":"default","latitude":38.8893,"longitude":-77.0501}]},
+"context":{"address":{"mapbox_id":"dXJuOm1ieGFkcjo1","address_number":"2","street_name":"Lincoln Memorial Circle Southwest","name":"2 Lincoln Memorial Circle Southwest"},
+"street":{"mapbox_id":"dXJuOm1ieGFkcjo2","name":"Lincoln Memorial Circle Southwest"},"postcode":{"mapbox_id":"dXJuOm1ieHBsYzo3","name":"20024"},
+"place":{"mapbox_id":"dXJuOm1ieHBsYzo4","name":"Washington","wikidata_id":"Q61"},
+"region":{"mapbox_id":"dXJuOm1ieHBsYzo5","name":"District of Columbia","wikidata_id":"Q3551781","region_code":"DC","region_code_full":"US-DC"},
+"country":{"mapbox_id":"dXJuOm1ieHBsYzox","name":"United States","wikidata_id":"Q30","country_code":"US","country_code_alpha_3":"USA"}},
+"match_code":{"address_number":"matched","street":"matched","postcode":"unmatched","place":"matched","region":"matched","locality":"not_applicable","country":"inferred","confidence":"exact"}}}],
+"attribution":"NOTICE: © 2023 Mapbox and its suppliers."}`
+
+func TestFastHttpGeocoderV6(t *testing.T) {
+	var uris []string
+	g := NewFastHttpGeocoderV6(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uris = append(uris, string(req.RequestURI()))
+			resp.SetBodyString(testV6RespBody)
+			return nil
+		})))
+
+	on := true
+	forward, err := g.ForwardGeocodeV6(context.Background(), &ForwardGeocodeV6Request{
+		Query: "2 Lincoln Memorial Cir SW, Washington", Country: "us", Permanent: &on,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reverse, err := g.ReverseGeocodeV6(context.Background(), &ReverseGeocodeV6Request{
+		GeoPoint: GeoPoint{Lon: -77.050164, Lat: 38.889223}, Types: []string{"address"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantURIs := []string{
+		"/search/geocode/v6/forward?access_token=token&q=2%20Lincoln%20Memorial%20Cir%20SW%2C%20Washington&country=us&permanent=true",
+		"/search/geocode/v6/reverse?access_token=token&latitude=38.889223&longitude=-77.050164&types=address",
+	}
+	for i, want := range wantURIs {
+		if !strings.HasSuffix(uris[i], want) {
+			t.Errorf("uri = %s, want suffix %s", uris[i], want)
+		}
+	}
+
+	for _, resp := range []*GeocodeV6Response{forward, reverse} {
+		if len(resp.Features) != 1 {
+			t.Fatalf("unexpected features %+v", resp.Features)
+		}
+		p := resp.Features[0].Properties
+		switch {
+		case p.FeatureType != PlaceTypeAddress || p.Coordinates.Accuracy != AccuracyRooftop:
+			t.Errorf("unexpected properties %+v", p)
+		case p.Coordinates.GeoPoint() != (GeoPoint{Lon: -77.050164, Lat: 38.889223}) || len(p.Coordinates.RoutablePoints) != 1:
+			t.Errorf("unexpected coordinates %+v", p.Coordinates)
+		case p.Context.Country == nil || p.Context.Country.CountryCode != "US" || p.Context.Country.Name != "United States":
+			t.Errorf("unexpected country %+v", p.Context.Country)
+		case p.Context.Region == nil || p.Context.Region.RegionCodeFull != "US-DC" || p.Context.Address.AddressNumber != "2":
+			t.Errorf("unexpected context %+v", p.Context)
+		case p.Context.Locality != nil || p.Context.Place.WikidataID != "Q61":
+			t.Errorf("unexpected place %+v", p.Context)
+		case p.MatchCode == nil || p.MatchCode.Confidence != "exact" || p.MatchCode.Postcode != "unmatched":
+			t.Errorf("unexpected match code %+v", p.MatchCode)
+		}
+	}
+
+	if _, err := g.ForwardGeocodeV6(context.Background(), &ForwardGeocodeV6Request{}); err == nil {
+		t.Error("query required error expected")
+	}
+}
+
+func TestFastHttpGeocoderV6_BatchGeocode(t *testing.T) {
+	var uri, method, body string
+	g := NewFastHttpGeocoderV6(AccessToken("token"), Permanent(true), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri, method, body = string(req.RequestURI()), string(req.Header.Method()), string(req.Body())
+			resp.SetBodyString(`{"batch":[` + testV6RespBody + `,{"type":"FeatureCollection","features":[]}]}`)
+			return nil
+		})))
+
+	on, off := true, false
+	ctx := WithContextLanguage(context.Background(), "en")
+	v5 := &ForwardGeocodeRequest{SearchText: "2 Lincoln Memorial Circle SW", Types: []string{"address"}, Proximity: &GeoPoint{Lon: -77, Lat: 38}}
+	resp, err := g.BatchGeocode(ctx, []ForwardGeocodeV6Request{
+		v5.V6(),
+		{Query: "nowhere", Limit: 1, Language: "de", Permanent: &on},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if method != "POST" || !strings.HasSuffix(uri, "/search/geocode/v6/batch?access_token=token&permanent=true") {
+		t.Errorf("unexpected request %s %s", method, uri)
+	}
+	wantBody := `[{"q":"2 Lincoln Memorial Circle SW","language":"en","types":["address"],"proximity":[-77,38]},{"q":"nowhere","language":"de","limit":1}]`
+	if body != wantBody {
+		t.Errorf("body = %s, want %s", body, wantBody)
+	}
+
+	if len(resp.Results) != 2 || len(resp.Results[0]) != 1 || len(resp.Results[1]) != 0 {
+		t.Fatalf("unexpected results %+v", resp.Results)
+	}
+	if !resp.Permanent {
+		t.Error("permanent batch expected")
+	}
+	if resp.Results[0][0].Properties.Context.Address.AddressNumber != "2" {
+		t.Errorf("unexpected feature %+v", resp.Results[0][0])
+	}
+
+	if _, err := g.BatchGeocode(ctx, make([]ForwardGeocodeV6Request, MaxBatchGeocodeQueries+1)); err == nil {
+		t.Error("batch size error expected")
+	}
+
+	var verr *ValidationError
+	_, err = g.BatchGeocode(ctx, []ForwardGeocodeV6Request{{Query: "a"}, {Query: "b", Permanent: &off}})
+	if !errors.As(err, &verr) || verr.Field != "[1].Permanent" {
+		t.Errorf("mixed permanent error expected, got %v", err)
+	}
+
+	resp, err = g.BatchGeocode(ctx, []ForwardGeocodeV6Request{{Query: "a", Permanent: &off}, {Query: "b", Permanent: &off}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Permanent || strings.Contains(uri, "permanent") {
+		t.Errorf("temporary batch expected, uri %s", uri)
+	}
+}
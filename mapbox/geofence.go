@@ -0,0 +1,121 @@
+package mapbox
+
+import (
+	"context"
+)
+
+// Region is an area results are allowed in, either Bbox or Polygon should be set.
+type Region struct {
+	// Name describes the region, e.g. a country code.
+	Name string
+	// Bbox in minLon,minLat,maxLon,maxLat order.
+	Bbox []float64
+	// Polygon is a ring of points, it is closed implicitly, holes are not supported.
+	Polygon []GeoPoint
+}
+
+// Contains reports whether p is inside the region, points on the edge could be either inside or outside.
+func (r Region) Contains(p GeoPoint) bool {
+	if len(r.Bbox) == 4 {
+		return p.Lon >= r.Bbox[0] && p.Lon <= r.Bbox[2] && p.Lat >= r.Bbox[1] && p.Lat <= r.Bbox[3]
+	}
+
+	// ray casting
+	inside := false
+	for i, j := 0, len(r.Polygon)-1; i < len(r.Polygon); j, i = i, i+1 {
+		a, b := r.Polygon[i], r.Polygon[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lon < (b.Lon-a.Lon)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// GeofenceResults drops forward geocode and search box retrieve and category results outside all regions,
+// e.g. of operational countries. Results without coordinates, e.g. search box suggestions, are kept.
+// Dropped results are counted in responses Filtered field.
+// Forward geocode responses decoded with CustomDecoder bypass the geofence, the decoder gets all results.
+func GeofenceResults(regions ...Region) Option {
+	return func(c config) config {
+		c.geofence = append([]Region(nil), regions...)
+		return c
+	}
+}
+
+// OnGeofenceDropped sets a hook called with number of results dropped by GeofenceResults, e.g. to count them in metrics.
+// It is called only if any result is dropped.
+func OnGeofenceDropped(hook func(ctx context.Context, op string, dropped int)) Option {
+	return func(c config) config {
+		c.onGeofenceDropped = hook
+		return c
+	}
+}
+
+// inGeofence reports whether p is in any geofence region, any point is if geofence is not set.
+func (c *config) inGeofence(p GeoPoint) bool {
+	if len(c.geofence) == 0 {
+		return true
+	}
+	for _, r := range c.geofence {
+		if r.Contains(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// reportGeofenceDropped calls dropped hook if set.
+func (c *config) reportGeofenceDropped(ctx context.Context, op string, dropped int) {
+	if dropped > 0 && c.onGeofenceDropped != nil {
+		c.onGeofenceDropped(ctx, op, dropped)
+	}
+}
+
+// geofenceFeatures drops features outside geofence in place and returns number of dropped features.
+func (c *config) geofenceFeatures(ctx context.Context, op string, features []Feature) ([]Feature, int) {
+	if len(c.geofence) == 0 {
+		return features, 0
+	}
+
+	kept := features[:0]
+	for _, f := range features {
+		p, ok := featurePoint(f.Center, f.Geometry)
+		if !ok || c.inGeofence(p) {
+			kept = append(kept, f)
+		}
+	}
+
+	dropped := len(features) - len(kept)
+	c.reportGeofenceDropped(ctx, op, dropped)
+	return kept, dropped
+}
+
+// geofenceSearchBox drops search box features outside geofence in place and returns number of dropped features.
+func (c *config) geofenceSearchBox(ctx context.Context, op string, features []SearchBoxFeature) ([]SearchBoxFeature, int) {
+	if len(c.geofence) == 0 {
+		return features, 0
+	}
+
+	kept := features[:0]
+	for _, f := range features {
+		p, ok := featurePoint(nil, f.Geometry)
+		if !ok || c.inGeofence(p) {
+			kept = append(kept, f)
+		}
+	}
+
+	dropped := len(features) - len(kept)
+	c.reportGeofenceDropped(ctx, op, dropped)
+	return kept, dropped
+}
+
+// featurePoint returns center if set or point geometry coordinates.
+func featurePoint(center []float64, g Geometry) (GeoPoint, bool) {
+	switch {
+	case len(center) >= 2:
+		return GeoPoint{Lon: center[0], Lat: center[1]}, true
+	case len(g.Coordinates) >= 2:
+		return GeoPoint{Lon: g.Coordinates[0], Lat: g.Coordinates[1]}, true
+	}
+	return GeoPoint{}, false
+}
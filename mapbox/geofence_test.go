@@ -0,0 +1,82 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestRegion_Contains(t *testing.T) {
+	triangle := Region{Polygon: []GeoPoint{{Lon: 0, Lat: 0}, {Lon: 10, Lat: 0}, {Lon: 0, Lat: 10}}}
+	box := Region{Bbox: []float64{-1, -1, 1, 1}}
+
+	tests := []struct {
+		name   string
+		region Region
+		p      GeoPoint
+		want   bool
+	}{
+		{name: "inside polygon", region: triangle, p: GeoPoint{Lon: 2, Lat: 2}, want: true},
+		{name: "outside polygon", region: triangle, p: GeoPoint{Lon: 6, Lat: 6}},
+		{name: "inside bbox", region: box, p: GeoPoint{Lon: 0.5, Lat: -0.5}, want: true},
+		{name: "outside bbox", region: box, p: GeoPoint{Lon: 2, Lat: 0}},
+		{name: "empty region", p: GeoPoint{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.region.Contains(tt.p); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeofenceResults(t *testing.T) {
+	dropped := map[string]int{}
+	opts := []Option{
+		AccessToken("token"),
+		GeofenceResults(Region{Name: "berlin", Bbox: []float64{13, 52, 14, 53}}),
+		OnGeofenceDropped(func(ctx context.Context, op string, n int) {
+			dropped[op] += n
+		}),
+	}
+
+	g := NewFastHttpGeocoder(append(opts, HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["cafe"],"features":[
+{"id":"poi.1","text":"Berlin cafe","center":[13.4,52.5]},
+{"id":"poi.2","text":"Paris cafe","center":[2.35,48.85]},
+{"id":"poi.3","text":"Unknown cafe"}]}`)
+			return nil
+		})))...)
+
+	resp, err := g.ForwardGeocode(context.Background(), &ForwardGeocodeRequest{SearchText: "cafe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Features) != 2 || resp.Features[0].ID != "poi.1" || resp.Features[1].ID != "poi.3" || resp.Filtered != 1 {
+		t.Errorf("unexpected features %+v filtered %d", resp.Features, resp.Filtered)
+	}
+
+	s := NewFastHttpSearchBox(append(opts, HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.SetBodyString(`{"type":"FeatureCollection","features":[
+{"type":"Feature","geometry":{"type":"Point","coordinates":[2.35,48.85]},"properties":{"mapbox_id":"a","name":"Paris cafe"}},
+{"type":"Feature","geometry":{"type":"Point","coordinates":[13.4,52.5]},"properties":{"mapbox_id":"b","name":"Berlin cafe"}}]}`)
+			return nil
+		})))...)
+
+	cat, err := s.CategorySearch(context.Background(), &CategorySearchRequest{Category: CategoryCafe})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cat.Features) != 1 || cat.Features[0].Properties.MapboxID != "b" || cat.Filtered != 1 {
+		t.Errorf("unexpected features %+v filtered %d", cat.Features, cat.Filtered)
+	}
+
+	if dropped["forward geocode"] != 1 || dropped["category search"] != 1 {
+		t.Errorf("dropped = %v", dropped)
+	}
+}
@@ -0,0 +1,282 @@
+package mapbox
+
+import (
+	"math"
+	"strings"
+)
+
+const earthRadiusMeters = 6371008.8
+
+// Distance returns great-circle distance between points in meters.
+func Distance(a, b GeoPoint) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// LineString is an ordered list of points, e.g. a decoded route geometry.
+type LineString []GeoPoint
+
+// Length returns line length in meters.
+func (l LineString) Length() float64 {
+	var length float64
+	for i := 1; i < len(l); i++ {
+		length += Distance(l[i-1], l[i])
+	}
+	return length
+}
+
+// Slice returns part of the line between from and to offsets in meters along it,
+// e.g. Slice(0, 2000) is the geometry of the next 2 km.
+// Cut points are interpolated, to is clamped to the line length.
+// It returns nil if from is past the line end.
+func (l LineString) Slice(from, to float64) LineString {
+	if len(l) < 2 || to <= from {
+		return nil
+	}
+	if from < 0 {
+		from = 0
+	}
+
+	var (
+		out    LineString
+		walked float64
+	)
+	for i := 1; i < len(l); i++ {
+		seg := Distance(l[i-1], l[i])
+		next := walked + seg
+
+		if out == nil && (from < next || i == len(l)-1 && from == next) {
+			out = append(out, interpolate(l[i-1], l[i], from-walked, seg))
+		}
+		if out != nil {
+			if to <= next {
+				return append(out, interpolate(l[i-1], l[i], to-walked, seg))
+			}
+			out = append(out, l[i])
+		}
+
+		walked = next
+	}
+
+	return out
+}
+
+// LegGeometry returns geometry of i-th route leg. It is joined from the leg steps geometries if steps were requested,
+// otherwise it is cut from the route geometry by legs distances, so it is as detailed as requested overview.
+// It is nil if i is out of range or there is no geometry.
+func (r *Route) LegGeometry(i int) LineString {
+	if i < 0 || i >= len(r.Legs) {
+		return nil
+	}
+
+	if steps := r.Legs[i].Steps; len(steps) > 0 {
+		var line LineString
+		for j := range steps {
+			line = joinLines(line, steps[j].Geometry.LineString())
+		}
+		return line
+	}
+
+	line := r.Geometry.LineString()
+	if len(line) < 2 || r.Distance <= 0 {
+		return nil
+	}
+
+	var from float64
+	for j := 0; j < i; j++ {
+		from += r.Legs[j].Distance
+	}
+	to := from + r.Legs[i].Distance
+	if i == len(r.Legs)-1 {
+		to = math.Inf(1)
+	}
+
+	// distances are measured along roads, so they are scaled to the geometry length
+	scale := line.Length() / r.Distance
+	return line.Slice(from*scale, to*scale)
+}
+
+// StepGeometry returns geometry of j-th step of i-th route leg.
+// It is nil if steps were not requested or indexes are out of range.
+func (r *Route) StepGeometry(i, j int) LineString {
+	if i < 0 || i >= len(r.Legs) || j < 0 || j >= len(r.Legs[i].Steps) {
+		return nil
+	}
+	return r.Legs[i].Steps[j].Geometry.LineString()
+}
+
+// joinLines appends b to a skipping b start if it is a's end.
+func joinLines(a, b LineString) LineString {
+	if len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[0] {
+		b = b[1:]
+	}
+	return append(a, b...)
+}
+
+// interpolate returns point at offset meters from a towards b, seg is a distance between them.
+func interpolate(a, b GeoPoint, offset, seg float64) GeoPoint {
+	if seg == 0 {
+		return a
+	}
+	f := offset / seg
+	return GeoPoint{
+		Lon: a.Lon + (b.Lon-a.Lon)*f,
+		Lat: a.Lat + (b.Lat-a.Lat)*f,
+	}
+}
+
+// DistanceTo returns distance in meters from p to the closest point of the line.
+// Segments are projected to a plane, so it is accurate for distances up to tens of kilometers.
+func (l LineString) DistanceTo(p GeoPoint) float64 {
+	switch len(l) {
+	case 0:
+		return math.Inf(1)
+	case 1:
+		return Distance(l[0], p)
+	}
+
+	min := math.Inf(1)
+	for i := 1; i < len(l); i++ {
+		min = math.Min(min, Distance(closestOnSegment(l[i-1], l[i], p), p))
+	}
+	return min
+}
+
+// closestOnSegment returns point of a-b segment closest to p in equirectangular projection around p.
+func closestOnSegment(a, b, p GeoPoint) GeoPoint {
+	k := math.Cos(p.Lat * math.Pi / 180)
+	ax, ay := (a.Lon-p.Lon)*k, a.Lat-p.Lat
+	bx, by := (b.Lon-p.Lon)*k, b.Lat-p.Lat
+	dx, dy := bx-ax, by-ay
+
+	d := dx*dx + dy*dy
+	if d == 0 {
+		return a
+	}
+	t := math.Max(0, math.Min(1, -(ax*dx+ay*dy)/d))
+
+	return GeoPoint{Lon: a.Lon + (b.Lon-a.Lon)*t, Lat: a.Lat + (b.Lat-a.Lat)*t}
+}
+
+// Simplify returns line with points closer than tolerance meters to the simplified shape removed,
+// using Douglas-Peucker algorithm. End points are always kept.
+func (l LineString) Simplify(tolerance float64) LineString {
+	if len(l) < 3 || tolerance <= 0 {
+		return l
+	}
+
+	keep := make([]bool, len(l))
+	keep[0], keep[len(l)-1] = true, true
+	l.simplify(0, len(l)-1, tolerance, keep)
+
+	out := make(LineString, 0, len(l))
+	for i, p := range l {
+		if keep[i] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func (l LineString) simplify(from, to int, tolerance float64, keep []bool) {
+	farthest, max := -1, tolerance
+	for i := from + 1; i < to; i++ {
+		if d := Distance(closestOnSegment(l[from], l[to], l[i]), l[i]); d > max {
+			farthest, max = i, d
+		}
+	}
+	if farthest < 0 {
+		return
+	}
+
+	keep[farthest] = true
+	l.simplify(from, farthest, tolerance, keep)
+	l.simplify(farthest, to, tolerance, keep)
+}
+
+// Polyline encodes line with encoded polyline algorithm at precision 5, as GeometriesPolyline.
+func (l LineString) Polyline() string {
+	b := strings.Builder{}
+	var prevLat, prevLon int64
+	for _, p := range l {
+		lat, lon := int64(math.Round(p.Lat*1e5)), int64(math.Round(p.Lon*1e5))
+		writePolylineValue(&b, lat-prevLat)
+		writePolylineValue(&b, lon-prevLon)
+		prevLat, prevLon = lat, lon
+	}
+	return b.String()
+}
+
+// DecodePolyline decodes encoded polyline algorithm line, precision is 5 for GeometriesPolyline
+// and 6 for GeometriesPolyline6.
+func DecodePolyline(s string, precision int) (LineString, error) {
+	factor := math.Pow10(precision)
+
+	var (
+		line     LineString
+		lat, lon int64
+	)
+	for i := 0; i < len(s); {
+		dLat, n, err := readPolylineValue(s, i)
+		if err != nil {
+			return nil, err
+		}
+		i += n
+
+		dLon, n, err := readPolylineValue(s, i)
+		if err != nil {
+			return nil, err
+		}
+		i += n
+
+		lat, lon = lat+dLat, lon+dLon
+		line = append(line, GeoPoint{Lon: float64(lon) / factor, Lat: float64(lat) / factor})
+	}
+
+	return line, nil
+}
+
+// readPolylineValue reads a value starting at i-th byte of s, n is the number of bytes read.
+func readPolylineValue(s string, i int) (v int64, n int, err error) {
+	var (
+		u     uint64
+		shift uint
+	)
+	for ; i+n < len(s) && shift < 64; n++ {
+		b := s[i+n]
+		if b < 63 || b > 63+0x3f {
+			return 0, 0, errorf("invalid polyline character %q at %d", b, i+n)
+		}
+		b -= 63
+
+		u |= uint64(b&0x1f) << shift
+		shift += 5
+		if b < 0x20 {
+			v = int64(u >> 1)
+			if u&1 != 0 {
+				v = ^v
+			}
+			return v, n + 1, nil
+		}
+	}
+
+	return 0, 0, errorf("truncated polyline value at %d", i)
+}
+
+func writePolylineValue(b *strings.Builder, v int64) {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		b.WriteByte(byte(0x20|u&0x1f) + 63)
+		u >>= 5
+	}
+	b.WriteByte(byte(u) + 63)
+}
@@ -0,0 +1,139 @@
+package mapbox
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	// one degree of longitude on the equator
+	if d := Distance(GeoPoint{Lon: 0, Lat: 0}, GeoPoint{Lon: 1, Lat: 0}); math.Abs(d-111195) > 1 {
+		t.Errorf("Distance() = %v", d)
+	}
+}
+
+func TestLineString_Slice(t *testing.T) {
+	// about 1112m between points along the equator
+	line := LineString{{Lon: 0}, {Lon: 0.01}, {Lon: 0.02}, {Lon: 0.03}}
+	step := Distance(line[0], line[1])
+
+	tests := []struct {
+		name     string
+		from, to float64
+		want     LineString
+	}{
+		{name: "whole line", from: 0, to: 10 * step, want: line},
+		{name: "inside first segment", from: step / 4, to: step / 2, want: LineString{{Lon: 0.0025}, {Lon: 0.005}}},
+		{name: "across segments", from: step / 2, to: 2.5 * step, want: LineString{{Lon: 0.005}, {Lon: 0.01}, {Lon: 0.02}, {Lon: 0.025}}},
+		{name: "negative from", from: -10, to: step, want: LineString{{Lon: 0}, {Lon: 0.01}}},
+		{name: "past the end", from: 4 * step, to: 5 * step},
+		{name: "empty range", from: step, to: step},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := line.Slice(tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Slice() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if math.Abs(got[i].Lon-tt.want[i].Lon) > 1e-9 || math.Abs(got[i].Lat-tt.want[i].Lat) > 1e-9 {
+					t.Errorf("Slice() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	if l := line.Length(); math.Abs(l-3*step) > 1e-6 {
+		t.Errorf("Length() = %v", l)
+	}
+}
+
+func TestLineString_DistanceTo(t *testing.T) {
+	l := LineString{{Lon: 0, Lat: 0}, {Lon: 0.01, Lat: 0}}
+	if d := l.DistanceTo(GeoPoint{Lon: 0.005, Lat: 0.001}); math.Abs(d-111.2) > 0.5 {
+		t.Errorf("DistanceTo() = %v, want ~111.2", d)
+	}
+	if d := l.DistanceTo(GeoPoint{Lon: 0.02, Lat: 0}); math.Abs(d-Distance(GeoPoint{Lon: 0.01}, GeoPoint{Lon: 0.02})) > 0.01 {
+		t.Errorf("DistanceTo() past the end = %v", d)
+	}
+}
+
+func TestLineString_Polyline(t *testing.T) {
+	// the example of the encoded polyline algorithm format documentation
+	l := LineString{{Lat: 38.5, Lon: -120.2}, {Lat: 40.7, Lon: -120.95}, {Lat: 43.252, Lon: -126.453}}
+	if got := l.Polyline(); got != "_p~iF~ps|U_ulLnnqC_mqNvxq`@" {
+		t.Errorf("Polyline() = %s", got)
+	}
+}
+
+func TestLineString_Simplify(t *testing.T) {
+	// the middle point is about 11m off the straight line
+	l := LineString{{Lon: 0}, {Lon: 0.005, Lat: 0.0001}, {Lon: 0.01}}
+	if got := l.Simplify(20); len(got) != 2 || got[1] != l[2] {
+		t.Errorf("Simplify(20) = %v", got)
+	}
+	if got := l.Simplify(5); len(got) != 3 {
+		t.Errorf("Simplify(5) = %v", got)
+	}
+}
+
+func TestDecodePolyline(t *testing.T) {
+	// the example of the encoded polyline algorithm format description
+	line, err := DecodePolyline("_p~iF~ps|U_ulLnnqC_mqNvxq`@", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := LineString{{Lon: -120.2, Lat: 38.5}, {Lon: -120.95, Lat: 40.7}, {Lon: -126.453, Lat: 43.252}}
+	if len(line) != len(want) {
+		t.Fatalf("DecodePolyline() = %v, want %v", line, want)
+	}
+	for i := range line {
+		if math.Abs(line[i].Lon-want[i].Lon) > 1e-9 || math.Abs(line[i].Lat-want[i].Lat) > 1e-9 {
+			t.Errorf("DecodePolyline() = %v, want %v", line, want)
+		}
+	}
+	if s := line.Polyline(); s != "_p~iF~ps|U_ulLnnqC_mqNvxq`@" {
+		t.Errorf("Polyline() = %s", s)
+	}
+
+	line6, err := DecodePolyline("_izlhA~rlgdF", 6)
+	if err != nil || len(line6) != 1 || math.Abs(line6[0].Lat-38.5) > 1e-9 || math.Abs(line6[0].Lon+120.2) > 1e-9 {
+		t.Errorf("DecodePolyline(6) = %v, %v", line6, err)
+	}
+
+	for _, s := range []string{"_p~iF~ps|", "_p~iF", "_p~iF ~ps|U"} {
+		if _, err := DecodePolyline(s, 5); err == nil {
+			t.Errorf("DecodePolyline(%q) error expected", s)
+		}
+	}
+}
+
+func TestRoute_LegGeometry(t *testing.T) {
+	step := func(coordinates ...[]float64) RouteStep {
+		return RouteStep{Geometry: RouteGeometry{Type: "LineString", Coordinates: coordinates}}
+	}
+	withSteps := Route{Legs: []RouteLeg{
+		{Steps: []RouteStep{step([]float64{0, 0}, []float64{0.01, 0}), step([]float64{0.01, 0}, []float64{0.02, 0})}},
+		{Steps: []RouteStep{step([]float64{0.02, 0}, []float64{0.03, 0})}},
+	}}
+	if got := withSteps.LegGeometry(0); len(got) != 3 || got[2] != (GeoPoint{Lon: 0.02}) {
+		t.Errorf("LegGeometry(0) = %v", got)
+	}
+	if got := withSteps.StepGeometry(0, 1); len(got) != 2 || got[0] != (GeoPoint{Lon: 0.01}) {
+		t.Errorf("StepGeometry(0, 1) = %v", got)
+	}
+	if withSteps.StepGeometry(1, 1) != nil || withSteps.LegGeometry(2) != nil {
+		t.Error("out of range geometry must be nil")
+	}
+
+	// road distances are longer than the overview geometry, legs are cut proportionally
+	overview := lineRoute(0, 3000, []float64{0, 0}, []float64{0.01, 0}, []float64{0.03, 0})
+	overview.Legs = []RouteLeg{{Distance: 1000}, {Distance: 2000}}
+	first, second := overview.LegGeometry(0), overview.LegGeometry(1)
+	if len(first) != 2 || first[0] != (GeoPoint{}) || math.Abs(first[1].Lon-0.01) > 1e-9 {
+		t.Errorf("LegGeometry(0) = %v", first)
+	}
+	if len(second) < 2 || math.Abs(second[0].Lon-0.01) > 1e-9 || second[len(second)-1] != (GeoPoint{Lon: 0.03}) {
+		t.Errorf("LegGeometry(1) = %v", second)
+	}
+}
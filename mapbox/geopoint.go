@@ -0,0 +1,82 @@
+package mapbox
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FromLatLon returns GeoPoint of coordinates in lat,lon order, e.g. of systems other than mapbox.
+func FromLatLon(lat, lon float64) GeoPoint {
+	return GeoPoint{Lon: lon, Lat: lat}
+}
+
+// LatLon returns coordinates in lat,lon order.
+func (p GeoPoint) LatLon() (lat, lon float64) {
+	return p.Lat, p.Lon
+}
+
+// String formats point in mapbox lon,lat order with 6 decimal places, e.g. -77.050000,38.889000.
+func (p GeoPoint) String() string {
+	return formatPair(p.Lon, p.Lat)
+}
+
+// LatLonString formats point in lat,lon order with 6 decimal places, e.g. 38.889000,-77.050000.
+func (p GeoPoint) LatLonString() string {
+	return formatPair(p.Lat, p.Lon)
+}
+
+// ParseGeoPoint parses "lat,lon" string, e.g. 38.889,-77.05.
+// Use ParseLonLat for mapbox order.
+func ParseGeoPoint(s string) (GeoPoint, error) {
+	lat, lon, err := parsePair(s)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	return validGeoPoint(GeoPoint{Lon: lon, Lat: lat}, s, "ParseLonLat")
+}
+
+// ParseLonLat parses "lon,lat" string in mapbox order, e.g. -77.05,38.889.
+func ParseLonLat(s string) (GeoPoint, error) {
+	lon, lat, err := parsePair(s)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	return validGeoPoint(GeoPoint{Lon: lon, Lat: lat}, s, "ParseGeoPoint")
+}
+
+func parsePair(s string) (float64, float64, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return 0, 0, validationErrorf("", ConstraintFormat, "invalid coordinates %q, two comma separated numbers expected", s)
+	}
+
+	a, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, 0, validationErrorf("", ConstraintFormat, "invalid coordinates %q: %v", s, err)
+	}
+	b, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return 0, 0, validationErrorf("", ConstraintFormat, "invalid coordinates %q: %v", s, err)
+	}
+
+	return a, b, nil
+}
+
+// validGeoPoint checks ranges and hints the other parser if coordinates look swapped.
+func validGeoPoint(p GeoPoint, s, other string) (GeoPoint, error) {
+	if !validCoordinate(p.Lat, 90) {
+		if validCoordinate(p.Lon, 90) {
+			return GeoPoint{}, validationErrorf("Lat", ConstraintRange, "latitude of %q is out of range, coordinates look swapped, see %s", s, other)
+		}
+		return GeoPoint{}, validationErrorf("Lat", ConstraintRange, "latitude of %q is out of range", s)
+	}
+	if !validCoordinate(p.Lon, 180) {
+		return GeoPoint{}, validationErrorf("Lon", ConstraintRange, "longitude of %q is out of range", s)
+	}
+	return p, nil
+}
+
+func formatPair(a, b float64) string {
+	return strconv.FormatFloat(a, floatFormatNoExponent, 6, 64) + string(comma) +
+		strconv.FormatFloat(b, floatFormatNoExponent, 6, 64)
+}
@@ -0,0 +1,52 @@
+package mapbox
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGeoPoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(string) (GeoPoint, error)
+		s       string
+		want    GeoPoint
+		wantErr string
+	}{
+		{name: "lat,lon", parse: ParseGeoPoint, s: "38.889, -77.05", want: GeoPoint{Lon: -77.05, Lat: 38.889}},
+		{name: "lon,lat", parse: ParseLonLat, s: "-77.05,38.889", want: GeoPoint{Lon: -77.05, Lat: 38.889}},
+		{name: "swapped", parse: ParseGeoPoint, s: "-122.4,37.7", wantErr: "look swapped, see ParseLonLat"},
+		{name: "swapped lon,lat", parse: ParseLonLat, s: "55.75,137.6", wantErr: "latitude of \"55.75,137.6\" is out of range"},
+		{name: "out of range", parse: ParseGeoPoint, s: "10,181", wantErr: "longitude"},
+		{name: "not a number", parse: ParseGeoPoint, s: "a,1", wantErr: "invalid coordinates"},
+		{name: "single number", parse: ParseLonLat, s: "1", wantErr: "two comma separated numbers expected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.s)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("got %+v, %v, want %+v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeoPoint_Format(t *testing.T) {
+	p := FromLatLon(38.889, -77.05)
+	if lat, lon := p.LatLon(); lat != 38.889 || lon != -77.05 {
+		t.Errorf("LatLon() = %v, %v", lat, lon)
+	}
+	if s := p.String(); s != "-77.050000,38.889000" {
+		t.Errorf("String() = %s", s)
+	}
+	if s := p.LatLonString(); s != "38.889000,-77.050000" {
+		t.Errorf("LatLonString() = %s", s)
+	}
+}
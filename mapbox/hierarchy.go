@@ -0,0 +1,123 @@
+package mapbox
+
+import (
+	"strings"
+)
+
+// Feature place types.
+const (
+	PlaceTypeCountry      = "country"
+	PlaceTypeRegion       = "region"
+	PlaceTypePostcode     = "postcode"
+	PlaceTypeDistrict     = "district"
+	PlaceTypePlace        = "place"
+	PlaceTypeLocality     = "locality"
+	PlaceTypeNeighborhood = "neighborhood"
+	PlaceTypeAddress      = "address"
+	PlaceTypePOI          = "poi"
+)
+
+// HasPlaceType reports whether feature is of placeType.
+func (f *Feature) HasPlaceType(placeType string) bool {
+	for _, pt := range f.PlaceType {
+		if pt == placeType {
+			return true
+		}
+	}
+	return false
+}
+
+// Parent returns feature hierarchy item of placeType.
+// The feature itself is returned as Context if it is of placeType, its context is searched otherwise.
+func (f *Feature) Parent(placeType string) (Context, bool) {
+	if f.HasPlaceType(placeType) {
+		return f.Hierarchy()[0], true
+	}
+
+	for _, c := range f.Context {
+		if c.PlaceType() == placeType {
+			return c, true
+		}
+	}
+
+	return Context{}, false
+}
+
+// Country returns feature country, see Parent.
+func (f *Feature) Country() (Context, bool) {
+	return f.Parent(PlaceTypeCountry)
+}
+
+// Region returns feature region, e.g. a state, see Parent.
+func (f *Feature) Region() (Context, bool) {
+	return f.Parent(PlaceTypeRegion)
+}
+
+// Postcode returns feature postcode, see Parent.
+func (f *Feature) Postcode() (Context, bool) {
+	return f.Parent(PlaceTypePostcode)
+}
+
+// District returns feature district, see Parent.
+func (f *Feature) District() (Context, bool) {
+	return f.Parent(PlaceTypeDistrict)
+}
+
+// Place returns feature city or town, see Parent.
+func (f *Feature) Place() (Context, bool) {
+	return f.Parent(PlaceTypePlace)
+}
+
+// Locality returns feature locality, see Parent.
+func (f *Feature) Locality() (Context, bool) {
+	return f.Parent(PlaceTypeLocality)
+}
+
+// Neighborhood returns feature neighborhood, see Parent.
+func (f *Feature) Neighborhood() (Context, bool) {
+	return f.Parent(PlaceTypeNeighborhood)
+}
+
+// Hierarchy returns the feature itself as Context followed by its context, from the smallest to the largest area.
+func (f *Feature) Hierarchy() []Context {
+	h := make([]Context, 0, len(f.Context)+1)
+	h = append(h, Context{
+		ID:        f.ID,
+		Text:      f.Text,
+		Wikidata:  f.Properties.Wikidata,
+		ShortCode: f.Properties.ShortCode,
+	})
+	return append(h, f.Context...)
+}
+
+// WikidataIDs returns wikidata ids of the feature hierarchy by place type, e.g. place Q61.
+func (f *Feature) WikidataIDs() map[string]string {
+	ids := make(map[string]string)
+	for _, c := range f.Hierarchy() {
+		if c.Wikidata != "" {
+			ids[c.PlaceType()] = c.Wikidata
+		}
+	}
+	return ids
+}
+
+// ShortCodes returns ISO 3166 codes of the feature hierarchy by place type,
+// e.g. region US-DC and country us.
+func (f *Feature) ShortCodes() map[string]string {
+	codes := make(map[string]string)
+	for _, c := range f.Hierarchy() {
+		if c.ShortCode != "" {
+			codes[c.PlaceType()] = c.ShortCode
+		}
+	}
+	return codes
+}
+
+// PlaceType returns context item place type from its id, e.g. region for region.1753213251667470.
+func (c Context) PlaceType() string {
+	i := strings.IndexByte(c.ID, '.')
+	if i < 0 {
+		return ""
+	}
+	return c.ID[:i]
+}
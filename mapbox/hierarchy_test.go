@@ -0,0 +1,75 @@
+package mapbox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFeature_Parent(t *testing.T) {
+	resp := rawReverseGeoResp{}
+	if err := resp.UnmarshalJSON(testRespBody); err != nil {
+		t.Fatal(err)
+	}
+	address, region := resp.Features[0], resp.Features[4]
+
+	tests := []struct {
+		name      string
+		feature   Feature
+		placeType string
+		want      Context
+		wantOk    bool
+	}{
+		{
+			name:      "from context",
+			feature:   address,
+			placeType: PlaceTypeRegion,
+			want:      Context{ID: "region.1753213251667470", Text: "District of Columbia", Wikidata: "Q3551781", ShortCode: "US-DC"},
+			wantOk:    true,
+		},
+		{
+			name:      "feature itself",
+			feature:   region,
+			placeType: PlaceTypeRegion,
+			want:      Context{ID: "region.1753213251667470", Text: "District of Columbia", Wikidata: "Q3551781", ShortCode: "US-DC"},
+			wantOk:    true,
+		},
+		{
+			name:      "missing",
+			feature:   region,
+			placeType: PlaceTypePostcode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.feature.Parent(tt.placeType)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Parent() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	if c, ok := address.Postcode(); !ok || c.Text != "20024" {
+		t.Errorf("Postcode() = %+v, %v", c, ok)
+	}
+	if c, ok := address.Country(); !ok || c.ShortCode != "us" {
+		t.Errorf("Country() = %+v, %v", c, ok)
+	}
+}
+
+func TestFeature_WikidataIDs(t *testing.T) {
+	resp := rawReverseGeoResp{}
+	if err := resp.UnmarshalJSON(testRespBody); err != nil {
+		t.Fatal(err)
+	}
+	place := resp.Features[3]
+
+	wantIDs := map[string]string{PlaceTypePlace: "Q61", PlaceTypeRegion: "Q3551781", PlaceTypeCountry: "Q30"}
+	if ids := place.WikidataIDs(); !reflect.DeepEqual(ids, wantIDs) {
+		t.Errorf("WikidataIDs() = %v, want %v", ids, wantIDs)
+	}
+
+	wantCodes := map[string]string{PlaceTypeRegion: "US-DC", PlaceTypeCountry: "us"}
+	if codes := place.ShortCodes(); !reflect.DeepEqual(codes, wantCodes) {
+		t.Errorf("ShortCodes() = %v, want %v", codes, wantCodes)
+	}
+}
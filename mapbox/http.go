@@ -1,9 +1,257 @@
 package mapbox
 
 import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/valyala/fasthttp"
 )
 
+const (
+	contentTypeJSON = "application/json"
+
+	respHeaderLink      = "Link"
+	respHeaderRequestID = "X-Request-Id"
+
+	respHeaderCacheControl = "Cache-Control"
+	respHeaderExpires      = "Expires"
+	respHeaderLastModified = "Last-Modified"
+	respHeaderETag         = "ETag"
+)
+
 type FastHttpClient interface {
 	Do(req *fasthttp.Request, resp *fasthttp.Response) error
 }
+
+// rawResponse is a copy of fasthttp response parts SDK needs after the response is released.
+type rawResponse struct {
+	statusCode int
+	body       []byte
+	rateLimit  RateLimit
+	// link is a pagination Link header value.
+	link string
+	// cache and contentType headers are passed through by binary responses, e.g. static tiles.
+	cache       CacheHeaders
+	contentType string
+	meta        Meta
+}
+
+// Meta describes how a response was obtained, e.g. to log per result provenance.
+type Meta struct {
+	// Endpoint is the SDK operation, e.g. reverse geocode.
+	Endpoint string
+	// Duration is the total call duration including retries.
+	Duration time.Duration
+	// Attempts is the number of requests made, it is greater than 1 if the call failed over or was retried.
+	Attempts int
+	// RequestID is the mapbox request id response header, mapbox support asks for it to investigate an issue.
+	RequestID string
+	// Timing is set if TimingBreakdown option is enabled.
+	Timing Timing
+}
+
+// do executes request with JSON body and copies response out of fasthttp pools.
+// op names the call in access log.
+func (c *config) do(ctx context.Context, op string, method, reqURI, body []byte) (*rawResponse, error) {
+	return c.doContent(ctx, op, method, reqURI, body, contentTypeJSON)
+}
+
+// doContent is do with body of contentType, e.g. a multipart form.
+func (c *config) doContent(ctx context.Context, op string, method, reqURI, body []byte, contentType string) (*rawResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	if call := contextCallOptions(ctx); call.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, call.timeout)
+		defer cancel()
+	}
+
+	if c.life != nil {
+		if !c.life.acquire() {
+			return nil, ErrClosed
+		}
+		defer c.life.release()
+	}
+
+	if err := c.spendBudget(ctx, op); err != nil {
+		return nil, err
+	}
+
+	if c.hosts == nil || len(reqURI) < len(c.rootAPI) || string(reqURI[:len(c.rootAPI)]) != c.rootAPI {
+		return c.doOnce(ctx, op, method, reqURI, body, contentType)
+	}
+
+	var (
+		resp     *rawResponse
+		err      error
+		attempts int
+	)
+
+	started := c.clock.Now()
+	for _, i := range c.hosts.order(started) {
+		hostURI := reqURI
+		if i > 0 {
+			hostURI = append([]byte(c.hosts.hosts[i]), reqURI[len(c.rootAPI):]...)
+		}
+
+		attempts++
+		resp, err = c.doOnce(ctx, op, method, hostURI, body, contentType)
+		c.hosts.report(i, resp, err, c.clock.Now())
+		if !failedOver(resp, err) {
+			break
+		}
+	}
+
+	if resp != nil {
+		resp.meta.Attempts = attempts
+		resp.meta.Duration = c.clock.Now().Sub(started)
+	}
+
+	return resp, err
+}
+
+// doOnce executes request with a single host.
+func (c *config) doOnce(ctx context.Context, op string, method, reqURI, body []byte, contentType string) (resp *rawResponse, err error) {
+	started := c.clock.Now()
+	defer func() {
+		c.logAccess(ctx, op, reqURI, started, resp, err)
+		c.recordCall(op, method, reqURI, body, started, resp, err)
+	}()
+
+	call := contextCallOptions(ctx)
+
+	freq := fasthttp.AcquireRequest()
+	fresp := fasthttp.AcquireResponse()
+	abandoned := false
+	defer func() {
+		// abandoned request is still used by the client, it is left to GC instead of the pools
+		if !abandoned {
+			fasthttp.ReleaseRequest(freq)
+			fasthttp.ReleaseResponse(fresp)
+		}
+	}()
+
+	freq.Header.SetMethodBytes(method)
+	freq.SetRequestURIBytes(reqURI)
+	if len(body) > 0 {
+		freq.Header.SetContentType(contentType)
+		freq.SetBody(body)
+	}
+	if call.noCache {
+		freq.Header.Set(reqHeaderCacheControl, noCache)
+	}
+	if call.idempotencyKey != "" {
+		freq.Header.Set(reqHeaderIdempotencyKey, call.idempotencyKey)
+	}
+
+	if call.timeout > 0 {
+		abandoned, err = c.doCancelable(ctx, freq, fresp)
+	} else {
+		err = c.client.Do(freq, fresp)
+	}
+	if err != nil {
+		return nil, err
+	}
+	transport := c.clock.Now().Sub(started)
+
+	respBytes := make([]byte, len(fresp.Body()))
+	copy(respBytes, fresp.Body())
+
+	resp = &rawResponse{
+		statusCode: fresp.Header.StatusCode(),
+		body:       respBytes,
+		rateLimit:  copyRateLimit(readRespRateLimit(fresp)),
+		link:       string(fresp.Header.Peek(respHeaderLink)),
+		cache: CacheHeaders{
+			CacheControl: string(fresp.Header.Peek(respHeaderCacheControl)),
+			Expires:      string(fresp.Header.Peek(respHeaderExpires)),
+			LastModified: string(fresp.Header.Peek(respHeaderLastModified)),
+			ETag:         string(fresp.Header.Peek(respHeaderETag)),
+		},
+		contentType: string(fresp.Header.ContentType()),
+		meta: Meta{
+			Endpoint:  op,
+			Duration:  c.clock.Now().Sub(started),
+			Attempts:  1,
+			RequestID: string(fresp.Header.Peek(respHeaderRequestID)),
+		},
+	}
+	if c.timing {
+		resp.meta.Timing.Transport = transport
+	}
+
+	return resp, nil
+}
+
+// doCancelable executes request until ctx is done, abandoned is true if request is still in progress.
+func (c *config) doCancelable(ctx context.Context, freq *fasthttp.Request, fresp *fasthttp.Response) (abandoned bool, err error) {
+	done := make(chan error, 1)
+	go func() {
+		done <- c.client.Do(freq, fresp)
+	}()
+
+	select {
+	case err := <-done:
+		return false, err
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
+
+func copyRateLimit(rl RateLimit) RateLimit {
+	return RateLimit{
+		Interval: append([]byte(nil), rl.Interval...),
+		Limit:    append([]byte(nil), rl.Limit...),
+		Reset:    append([]byte(nil), rl.Reset...),
+	}
+}
+
+// retryRateLimited repeats call while it is rejected with 429 Too Many Requests, at most maxRetries times.
+// Delay starts with backoff and is doubled each retry, X-Rate-Limit-Reset response header is preferred if present.
+// The last response is returned as is, so callers check its status code.
+func (c *config) retryRateLimited(ctx context.Context, maxRetries int, backoff time.Duration, call func() (*rawResponse, error)) (*rawResponse, error) {
+	started := c.clock.Now()
+	attempts := 0
+	for retry := 0; ; retry++ {
+		resp, err := call()
+		if err != nil {
+			return nil, err
+		}
+
+		// every call could fail over to several hosts
+		attempts += resp.meta.Attempts
+		if resp.statusCode != http.StatusTooManyRequests || retry >= maxRetries {
+			resp.meta.Attempts = attempts
+			resp.meta.Duration = c.clock.Now().Sub(started)
+			return resp, nil
+		}
+
+		wait := backoff
+		if reset := rateLimitResetIn(resp.rateLimit, c.clock.Now()); reset > 0 {
+			wait = reset
+		}
+		backoff *= 2
+
+		if err := c.clock.Sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rateLimitResetIn returns time left from now until X-Rate-Limit-Reset unix timestamp.
+func rateLimitResetIn(rl RateLimit, now time.Time) time.Duration {
+	if len(rl.Reset) == 0 {
+		return 0
+	}
+
+	reset, err := strconv.ParseInt(string(rl.Reset), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return time.Unix(reset, 0).Sub(now)
+}
@@ -0,0 +1,64 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+type fastHttpClientFunc func(req *fasthttp.Request, resp *fasthttp.Response) error
+
+func (f fastHttpClientFunc) Do(req *fasthttp.Request, resp *fasthttp.Response) error {
+	return f(req, resp)
+}
+
+func Test_config_do(t *testing.T) {
+	c := newConfig()
+	c.client = fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		if string(req.Header.Method()) != "POST" || string(req.Body()) != "{}" {
+			t.Errorf("unexpected request %s %s", req.Header.Method(), req.Body())
+		}
+		resp.Header.Set(respHeaderRateLimitLimit, "600")
+		resp.Header.Set("x-request-id", "req-1")
+		resp.SetStatusCode(fasthttp.StatusCreated)
+		resp.SetBodyString(`{"id":"1"}`)
+		return nil
+	})
+
+	resp, err := c.do(context.Background(), "test", []byte("POST"), []byte("/test"), []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.statusCode != fasthttp.StatusCreated || string(resp.body) != `{"id":"1"}` || string(resp.rateLimit.Limit) != "600" ||
+		resp.meta.RequestID != "req-1" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestRequestIDInErrors(t *testing.T) {
+	g := NewFastHttpGeocoder(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.Header.Set("X-Request-Id", "req-2")
+			resp.SetStatusCode(fasthttp.StatusInternalServerError)
+			return nil
+		})))
+
+	_, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{GeoPoint: GeoPoint{Lon: 1, Lat: 1}})
+	if err == nil || !strings.Contains(err.Error(), "request id req-2") {
+		t.Errorf("ReverseGeocode() error = %v, want request id", err)
+	}
+}
+
+func Test_build(t *testing.T) {
+	c := NewFastHttpUploads(RootAPI("https://example.com"), Username("user"))
+	if string(c.uploadsAPIURL.prefix) != "https://example.com/uploads/v1/user/" {
+		t.Errorf("unexpected api url %s", c.uploadsAPIURL.prefix)
+	}
+
+	c = NewFastHttpUploads(RootAPI("example.com"), Username("user"))
+	if _, err := c.UploadStatus(context.Background(), "upload"); err == nil || err.Error() != `invalid root api "example.com"` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package mapbox
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// submissionTTL is a time a submitted job is returned for retried submissions with the same idempotency key.
+const submissionTTL = 30 * time.Minute
+
+// idempotencyKey derives a key from request body, so retried submissions of the same document share it.
+func idempotencyKey(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+type submission struct {
+	done chan struct{}
+	resp *OptimizationJobResponse
+	err  error
+	// expires is zero while submission is in flight.
+	expires time.Time
+}
+
+// submissions dedupes job submissions by idempotency key, it is shared by service copies.
+type submissions struct {
+	mu    sync.Mutex
+	byKey map[string]*submission
+}
+
+func newSubmissions() *submissions {
+	return &submissions{byKey: make(map[string]*submission)}
+}
+
+// do calls submit once per key, concurrent and later calls with the key get its result until it expires.
+// Failed submissions are forgotten, so they could be retried.
+func (s *submissions) do(ctx context.Context, key string, clock Clock,
+	submit func() (*OptimizationJobResponse, error)) (*OptimizationJobResponse, error) {
+	now := clock.Now()
+
+	s.mu.Lock()
+	for k, sub := range s.byKey {
+		if !sub.expires.IsZero() && !now.Before(sub.expires) {
+			delete(s.byKey, k)
+		}
+	}
+	if sub, ok := s.byKey[key]; ok {
+		s.mu.Unlock()
+
+		select {
+		case <-sub.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if sub.err != nil {
+			return nil, sub.err
+		}
+		resp := *sub.resp
+		return &resp, nil
+	}
+
+	sub := &submission{done: make(chan struct{})}
+	s.byKey[key] = sub
+	s.mu.Unlock()
+
+	sub.resp, sub.err = submit()
+
+	s.mu.Lock()
+	if sub.err != nil {
+		delete(s.byKey, key)
+	} else {
+		sub.expires = clock.Now().Add(submissionTTL)
+	}
+	s.mu.Unlock()
+	close(sub.done)
+
+	return sub.resp, sub.err
+}
@@ -0,0 +1,370 @@
+package mapbox
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+const (
+	contoursMinutes = "contours_minutes"
+	contoursMeters  = "contours_meters"
+	contoursColors  = "contours_colors"
+	polygons        = "polygons"
+	denoise         = "denoise"
+	generalize      = "generalize"
+
+	// mapbox isochrone API limits
+	maxIsochroneContours = 4
+	maxIsochroneMinutes  = 60
+	maxIsochroneMeters   = 100000
+
+	geometryTypeLineString = "LineString"
+
+	// snapOffset is a latitude offset of about 10 meters of the point origin is routed to when snapped.
+	snapOffset = 0.0001
+
+	// IsochroneMetricTime is a metric of ContoursMinutes contours.
+	IsochroneMetricTime = "time"
+	// IsochroneMetricDistance is a metric of ContoursMeters contours.
+	IsochroneMetricDistance = "distance"
+)
+
+// IsochroneRequest describes areas reachable from Origin.
+// Exactly one of ContoursMinutes or ContoursMeters should be set.
+type IsochroneRequest struct {
+	// Profile default to ProfileDriving.
+	Profile DirectionsProfile
+	Origin  GeoPoint
+	// ContoursMinutes are up to 4 increasing times from 1 to 60 minutes.
+	ContoursMinutes []int
+	// ContoursMeters are up to 4 increasing distances from 1 to 100000 meters.
+	ContoursMeters []int
+	// ContoursColors are hex colors without #, one per contour, e.g. ff0000.
+	ContoursColors []string
+	// Polygons returns contours as polygons instead of lines.
+	Polygons bool
+	// Denoise from 0 to 1 drops contours smaller than the fraction of the largest one, mapbox default is 1.
+	Denoise *float64
+	// Generalize is a contours simplification tolerance in meters.
+	Generalize float64
+	// SnapOrigin sets IsochroneResponse.SnappedOrigin with one more directions API request,
+	// as isochrone API responses don't say where the origin was snapped to.
+	SnapOrigin bool
+}
+
+// IsochroneFeature is a contour of the area reachable within Properties.Contour minutes or meters.
+type IsochroneFeature struct {
+	Type       string              `json:"type"`
+	Geometry   IsochroneGeometry   `json:"geometry"`
+	Properties IsochroneProperties `json:"properties"`
+}
+
+// IsochroneProperties describes a contour and its style.
+type IsochroneProperties struct {
+	// Contour is the contour time in minutes or distance in meters depending on Metric.
+	Contour float64 `json:"contour"`
+	// Metric is IsochroneMetricTime or IsochroneMetricDistance.
+	Metric  string  `json:"metric"`
+	Color   string  `json:"color"`
+	Opacity float64 `json:"opacity"`
+}
+
+// IsochroneGeometry is a GeoJSON LineString or Polygon contour.
+type IsochroneGeometry struct {
+	Type string
+	// Coordinates are rings of a Polygon, the first one is the exterior, LineString is a single ring.
+	Coordinates [][][]float64
+}
+
+// easyjson:json
+type polygonGeometry struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+// UnmarshalEasyJSON reads GeoJSON LineString or Polygon object.
+func (g *IsochroneGeometry) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	*g = IsochroneGeometry{}
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+
+	data := in.Raw()
+	p := polygonGeometry{}
+	if err := p.UnmarshalJSON(data); err == nil {
+		g.Type, g.Coordinates = p.Type, p.Coordinates
+		return
+	}
+
+	l := lineGeometry{}
+	if err := l.UnmarshalJSON(data); err != nil {
+		in.AddError(err)
+		return
+	}
+	g.Type, g.Coordinates = l.Type, [][][]float64{l.Coordinates}
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (g *IsochroneGeometry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	g.UnmarshalEasyJSON(&r)
+	return r.Error()
+}
+
+// MarshalEasyJSON writes geometry back the same way mapbox returns it.
+func (g IsochroneGeometry) MarshalEasyJSON(out *jwriter.Writer) {
+	if g.Type == geometryTypeLineString && len(g.Coordinates) == 1 {
+		lineGeometry{Type: g.Type, Coordinates: g.Coordinates[0]}.MarshalEasyJSON(out)
+		return
+	}
+	polygonGeometry{Type: g.Type, Coordinates: g.Coordinates}.MarshalEasyJSON(out)
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (g IsochroneGeometry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	g.MarshalEasyJSON(&w)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// Rings returns geometry rings as line strings.
+func (g IsochroneGeometry) Rings() []LineString {
+	rings := make([]LineString, 0, len(g.Coordinates))
+	for _, r := range g.Coordinates {
+		ring := make(LineString, 0, len(r))
+		for _, c := range r {
+			if len(c) >= 2 {
+				ring = append(ring, GeoPoint{Lon: c[0], Lat: c[1]})
+			}
+		}
+		rings = append(rings, ring)
+	}
+	return rings
+}
+
+// IsochroneOrigin is the requested origin snapped to the road network, contours are built from it.
+type IsochroneOrigin struct {
+	Location GeoPoint
+	// Name of the street the origin snapped to.
+	Name string
+	// Distance in meters between the requested and the snapped origin,
+	// a large one means the contours could start e.g. on a highway across a river.
+	Distance float64
+}
+
+// easyjson:json
+type rawIsochroneResp struct {
+	Type     string             `json:"type"`
+	Features []IsochroneFeature `json:"features"`
+}
+
+// IsochroneResponse wraps isochrone contours.
+type IsochroneResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+	// Decoded is set instead of Features if CustomDecoder is registered for the endpoint
+	Decoded interface{}
+
+	// Features are contours from the largest to the smallest one.
+	Features []IsochroneFeature
+	// SnappedOrigin is set if requested with SnapOrigin.
+	SnappedOrigin *IsochroneOrigin
+}
+
+// Isochrone covers mapbox isochrone API.
+type Isochrone interface {
+	// Isochrone calls isochrone/v1 mapbox API
+	Isochrone(ctx context.Context, req *IsochroneRequest, opts ...CallOption) (*IsochroneResponse, error)
+}
+
+// FastHttpIsochrone is a fasthttp Isochrone implementation
+type FastHttpIsochrone struct {
+	config
+
+	isochroneAPIURL EndpointURL
+	// directions snaps origins, it shares the config.
+	directions *FastHttpDirections
+
+	stringBufPull *stringsBufferPool
+}
+
+// Isochrone calls isochrone/v1 mapbox API thought fasthttp client.
+// Snapped origin is found with a directions API request from the origin if SnapOrigin is set.
+func (c *FastHttpIsochrone) Isochrone(ctx context.Context, req *IsochroneRequest, opts ...CallOption) (*IsochroneResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	profile := req.Profile
+	if profile == "" {
+		profile = ProfileDriving
+	}
+
+	values := make(map[string]string, 6)
+	if len(req.ContoursMinutes) > 0 {
+		values[contoursMinutes] = joinInts(req.ContoursMinutes)
+	}
+	if len(req.ContoursMeters) > 0 {
+		values[contoursMeters] = joinInts(req.ContoursMeters)
+	}
+	if len(req.ContoursColors) > 0 {
+		values[contoursColors] = strings.Join(req.ContoursColors, ",")
+	}
+	if req.Polygons {
+		values[polygons] = trueStr
+	}
+	if req.Denoise != nil {
+		values[denoise] = strconv.FormatFloat(*req.Denoise, floatFormatNoExponent, -1, 64)
+	}
+	if req.Generalize > 0 {
+		values[generalize] = strconv.FormatFloat(req.Generalize, floatFormatNoExponent, -1, 64)
+	}
+
+	origin := req.Origin.String()
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.isochroneAPIURL.Write(buf, values, string(profile), slash, origin)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "isochrone", reqURI, values, logKeyProfile, string(profile), logKeyCoordinate, origin)
+
+	resp, err := c.do(ctx, "isochrone", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "isochrone", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("isochrone", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	r := &IsochroneResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+	}
+
+	if decoded, ok, err := c.customDecode(EndpointIsochrone, resp.body); ok {
+		if err != nil {
+			return nil, err
+		}
+		r.Decoded = decoded
+	} else {
+		respRaw := rawIsochroneResp{}
+		if err := c.decode(resp, &respRaw); err != nil {
+			return nil, errorf("failed to unmarshall isochrone resp %s: %w", string(resp.body), err)
+		}
+		r.Features = respRaw.Features
+	}
+
+	if req.SnapOrigin {
+		r.SnappedOrigin, err = c.snapOrigin(ctx, req.Origin, profile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// snapOrigin routes from origin to a point next to it, the first waypoint is the snapped origin.
+// Directions API rejects equal coordinates and the destination doesn't change where the origin snaps to.
+func (c *FastHttpIsochrone) snapOrigin(ctx context.Context, origin GeoPoint, profile DirectionsProfile) (*IsochroneOrigin, error) {
+	next := GeoPoint{Lon: origin.Lon, Lat: origin.Lat - snapOffset}
+	if origin.Lat < 0 {
+		next.Lat = origin.Lat + snapOffset
+	}
+
+	resp, err := c.directions.Directions(ctx, &DirectionsRequest{
+		Profile:     profile,
+		Coordinates: []GeoPoint{origin, next},
+		Overview:    OverviewFalse,
+	})
+	if err != nil {
+		return nil, errorf("failed to snap isochrone origin %s: %w", origin, err)
+	}
+	if len(resp.Waypoints) == 0 || len(resp.Waypoints[0].Location) < 2 {
+		return nil, errorf("failed to snap isochrone origin %s: no waypoints", origin)
+	}
+
+	w := resp.Waypoints[0]
+	return &IsochroneOrigin{Location: w.GeoPoint(), Name: w.Name, Distance: w.Distance}, nil
+}
+
+func (req *IsochroneRequest) validate() error {
+	if !validCoordinate(req.Origin.Lon, 180) || !validCoordinate(req.Origin.Lat, 90) {
+		return validationErrorf("Origin", ConstraintRange, "invalid origin %v,%v", req.Origin.Lon, req.Origin.Lat)
+	}
+
+	field, contours, max := "ContoursMinutes", req.ContoursMinutes, maxIsochroneMinutes
+	switch {
+	case len(req.ContoursMinutes) > 0 && len(req.ContoursMeters) > 0:
+		return validationErrorf("ContoursMeters", ConstraintDepends, "either contours minutes or meters could be set")
+	case len(req.ContoursMeters) > 0:
+		field, contours, max = "ContoursMeters", req.ContoursMeters, maxIsochroneMeters
+	case len(req.ContoursMinutes) == 0:
+		return validationErrorf("ContoursMinutes", ConstraintRequired, "contours minutes or meters are required")
+	}
+
+	if len(contours) > maxIsochroneContours {
+		return validationErrorf(field, ConstraintMaxItems, "too many contours %d, max is %d", len(contours), maxIsochroneContours)
+	}
+	for i, v := range contours {
+		if v < 1 || v > max {
+			return validationErrorf(indexField(field, i), ConstraintRange, "invalid contour %d, must be from 1 to %d", v, max)
+		}
+		if i > 0 && v <= contours[i-1] {
+			return validationErrorf(indexField(field, i), ConstraintRange, "contours must increase, got %d after %d", v, contours[i-1])
+		}
+	}
+
+	if len(req.ContoursColors) > 0 && len(req.ContoursColors) != len(contours) {
+		return validationErrorf("ContoursColors", ConstraintItems, "got %d colors for %d contours", len(req.ContoursColors), len(contours))
+	}
+	if req.Denoise != nil && (*req.Denoise < 0 || *req.Denoise > 1) {
+		return validationErrorf("Denoise", ConstraintRange, "invalid denoise %v, must be from 0 to 1", *req.Denoise)
+	}
+	if req.Generalize < 0 {
+		return validationErrorf("Generalize", ConstraintRange, "invalid generalize %v", req.Generalize)
+	}
+
+	return nil
+}
+
+func joinInts(ints []int) string {
+	s := make([]string, len(ints))
+	for i, v := range ints {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, ",")
+}
+
+func NewFastHttpIsochrone(opts ...Option) *FastHttpIsochrone {
+	c := FastHttpIsochrone{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.isochroneAPIURL = c.endpointURL("/isochrone/v1/mapbox/")
+	c.directions = &FastHttpDirections{
+		config:           c.config,
+		directionsAPIURL: c.endpointURL("/directions/v5/mapbox/"),
+		stringBufPull:    c.stringBufPull,
+	}
+
+	return &c
+}
@@ -0,0 +1,103 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+const testIsochroneRespBody = `{"type":"FeatureCollection","features":[
+{"type":"Feature","geometry":{"type":"Polygon","coordinates":[[[-77.06,38.88],[-77.04,38.88],[-77.05,38.9],[-77.06,38.88]]]},
+"properties":{"contour":10,"metric":"time","color":"ff0000","opacity":0.33,"fill":"ff0000"}},
+{"type":"Feature","geometry":{"type":"LineString","coordinates":[[-77.055,38.885],[-77.045,38.885]]},
+"properties":{"contour":5,"metric":"time","color":"00ff00","opacity":0.33}}]}`
+
+func TestFastHttpIsochrone_Isochrone(t *testing.T) {
+	var uris []string
+	c := NewFastHttpIsochrone(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			uris = append(uris, uri)
+			if strings.Contains(uri, "/directions/v5/") {
+				resp.SetBodyString(`{"code":"Ok","routes":[{"duration":0,"distance":0,"legs":[]}],` +
+					`"waypoints":[{"name":"Rock Creek Parkway","location":[-77.0551,38.8917],"distance":312.5},` +
+					`{"name":"Rock Creek Parkway","location":[-77.0551,38.8917],"distance":312.5}]}`)
+				return nil
+			}
+			resp.SetBodyString(testIsochroneRespBody)
+			return nil
+		})))
+
+	denoise := 0.5
+	resp, err := c.Isochrone(context.Background(), &IsochroneRequest{
+		Profile:         ProfileWalking,
+		Origin:          GeoPoint{Lon: -77.05, Lat: 38.889},
+		ContoursMinutes: []int{5, 10},
+		ContoursColors:  []string{"00ff00", "ff0000"},
+		Polygons:        true,
+		Denoise:         &denoise,
+		SnapOrigin:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "https://api.mapbox.com/isochrone/v1/mapbox/walking/-77.050000,38.889000?access_token=token" +
+		"&contours_colors=00ff00,ff0000&contours_minutes=5,10&denoise=0.5&polygons=true"
+	if len(uris) != 2 || uris[0] != want {
+		t.Fatalf("URIs = %v, want %s first", uris, want)
+	}
+	if !strings.HasPrefix(uris[1], "https://api.mapbox.com/directions/v5/mapbox/walking/-77.050000,38.889000;-77.050000,38.888900?") {
+		t.Errorf("unexpected snap URI %s", uris[1])
+	}
+
+	if len(resp.Features) != 2 {
+		t.Fatalf("unexpected features %+v", resp.Features)
+	}
+	polygon, line := resp.Features[0], resp.Features[1]
+	switch {
+	case polygon.Geometry.Type != "Polygon" || len(polygon.Geometry.Rings()) != 1 || len(polygon.Geometry.Rings()[0]) != 4:
+		t.Errorf("unexpected polygon %+v", polygon.Geometry)
+	case polygon.Properties.Contour != 10 || polygon.Properties.Metric != IsochroneMetricTime:
+		t.Errorf("unexpected properties %+v", polygon.Properties)
+	case line.Geometry.Type != "LineString" || line.Geometry.Rings()[0][1] != (GeoPoint{Lon: -77.045, Lat: 38.885}):
+		t.Errorf("unexpected line %+v", line.Geometry)
+	}
+
+	wantOrigin := IsochroneOrigin{Location: GeoPoint{Lon: -77.0551, Lat: 38.8917}, Name: "Rock Creek Parkway", Distance: 312.5}
+	if resp.SnappedOrigin == nil || *resp.SnappedOrigin != wantOrigin {
+		t.Errorf("SnappedOrigin = %+v, want %+v", resp.SnappedOrigin, wantOrigin)
+	}
+}
+
+func TestIsochroneRequest_validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       IsochroneRequest
+		wantField string
+	}{
+		{"valid meters", IsochroneRequest{ContoursMeters: []int{500, 1000}}, ""},
+		{"no contours", IsochroneRequest{}, "ContoursMinutes"},
+		{"both contours", IsochroneRequest{ContoursMinutes: []int{5}, ContoursMeters: []int{500}}, "ContoursMeters"},
+		{"too many", IsochroneRequest{ContoursMinutes: []int{1, 2, 3, 4, 5}}, "ContoursMinutes"},
+		{"out of range", IsochroneRequest{ContoursMinutes: []int{5, 61}}, "ContoursMinutes[1]"},
+		{"not increasing", IsochroneRequest{ContoursMeters: []int{1000, 500}}, "ContoursMeters[1]"},
+		{"colors", IsochroneRequest{ContoursMinutes: []int{5, 10}, ContoursColors: []string{"ff0000"}}, "ContoursColors"},
+		{"origin", IsochroneRequest{Origin: GeoPoint{Lon: 200}, ContoursMinutes: []int{5}}, "Origin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.validate()
+			var verr *ValidationError
+			switch {
+			case tt.wantField == "" && err != nil:
+				t.Errorf("validate() error = %v", err)
+			case tt.wantField != "" && (!errors.As(err, &verr) || verr.Field != tt.wantField):
+				t.Errorf("validate() error = %v, want %s field", err, tt.wantField)
+			}
+		})
+	}
+}
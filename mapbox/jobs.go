@@ -0,0 +1,248 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// JobKindUpload is an uploads API job.
+	JobKindUpload = "upload"
+	// JobKindTileset is a tiling service publish job.
+	JobKindTileset = "tileset"
+	// JobKindRoutingProblem is an optimization v2 routing problem job.
+	JobKindRoutingProblem = "routing_problem"
+)
+
+var (
+	postMethod = []byte("POST")
+)
+
+// JobEvent describes a finished long running job.
+// easyjson:json
+type JobEvent struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+	// Error is set if the job failed.
+	Error string `json:"error,omitempty"`
+	// Upload is set for upload jobs.
+	Upload *UploadStatus `json:"upload,omitempty"`
+	// TilesetJob is set for tileset jobs.
+	TilesetJob *TilesetJob `json:"tileset_job,omitempty"`
+	// Solution is set for routing problem jobs.
+	Solution *RoutingSolution `json:"solution,omitempty"`
+}
+
+// JobPoller gets status of a long running job for JobWatcher.
+type JobPoller interface {
+	// PollJob returns event of the finished job or nil if it is still running.
+	PollJob(ctx context.Context, id string) (*JobEvent, error)
+}
+
+// UploadPoller polls uploads API jobs.
+type UploadPoller struct {
+	Uploads Uploads
+}
+
+// PollJob gets upload status.
+func (p UploadPoller) PollJob(ctx context.Context, id string) (*JobEvent, error) {
+	resp, err := p.Uploads.UploadStatus(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status := resp.Status
+	if !status.Complete && status.Error == "" {
+		return nil, nil
+	}
+
+	return &JobEvent{Kind: JobKindUpload, ID: id, Error: status.Error, Upload: &status}, nil
+}
+
+// TilesetJobPoller polls tiling service jobs of a tileset.
+type TilesetJobPoller struct {
+	Tilesets  Tilesets
+	TilesetID string
+}
+
+// PollJob gets tileset job, event Error is set if the job failed.
+func (p TilesetJobPoller) PollJob(ctx context.Context, id string) (*JobEvent, error) {
+	resp, err := p.Tilesets.TilesetJob(ctx, p.TilesetID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	job := resp.Job
+	if !job.Done() {
+		return nil, nil
+	}
+
+	event := &JobEvent{Kind: JobKindTileset, ID: id, TilesetJob: &job}
+	if job.Stage == TilesetJobFailed {
+		event.Error = "tileset job failed"
+	}
+	return event, nil
+}
+
+// RoutingProblemPoller polls optimization v2 routing problem jobs.
+type RoutingProblemPoller struct {
+	Optimization Optimization
+}
+
+// PollJob gets routing solution.
+func (p RoutingProblemPoller) PollJob(ctx context.Context, id string) (*JobEvent, error) {
+	resp, err := p.Optimization.RoutingSolution(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status != OptimizationJobComplete {
+		return nil, nil
+	}
+
+	return &JobEvent{Kind: JobKindRoutingProblem, ID: id, Solution: resp.Solution}, nil
+}
+
+// JobNotifier is notified when a watched job is finished.
+type JobNotifier interface {
+	Notify(ctx context.Context, event *JobEvent) error
+}
+
+// JobNotifierFunc allows to use a function as JobNotifier.
+type JobNotifierFunc func(ctx context.Context, event *JobEvent) error
+
+// Notify calls f.
+func (f JobNotifierFunc) Notify(ctx context.Context, event *JobEvent) error {
+	return f(ctx, event)
+}
+
+// WebhookNotifier posts JobEvent as JSON to a user provided URL.
+type WebhookNotifier struct {
+	url    []byte
+	client FastHttpClient
+}
+
+// NewWebhookNotifier creates WebhookNotifier, default fasthttp client is used if client is nil.
+func NewWebhookNotifier(url string, client FastHttpClient) *WebhookNotifier {
+	if client == nil {
+		client = &fasthttp.Client{}
+	}
+	return &WebhookNotifier{url: []byte(url), client: client}
+}
+
+// Notify posts event, any non 2xx response is an error.
+func (n *WebhookNotifier) Notify(ctx context.Context, event *JobEvent) error {
+	body, err := event.MarshalJSON()
+	if err != nil {
+		return errorf("failed to marshal job event: %w", err)
+	}
+
+	c := newConfig()
+	c.client = n.client
+	resp, err := c.do(ctx, "job webhook", postMethod, n.url, body)
+	if err != nil {
+		return err
+	}
+
+	if resp.statusCode < http.StatusOK || resp.statusCode >= http.StatusMultipleChoices {
+		return errorf("failed to notify %s statusCode %d resp %s", n.url, resp.statusCode, string(resp.body))
+	}
+
+	return nil
+}
+
+// JobWatcher polls many jobs from a single goroutine and notifies when they are finished,
+// so pipelines do not block a goroutine per job like WaitForUpload does.
+type JobWatcher struct {
+	notifier JobNotifier
+	interval time.Duration
+	clock    Clock
+
+	mu      sync.Mutex
+	pending map[string]JobPoller
+}
+
+// NewJobWatcher creates JobWatcher, interval default to 5s.
+// Only WithClock of opts is used, so polling could be simulated in tests.
+func NewJobWatcher(notifier JobNotifier, interval time.Duration, opts ...Option) *JobWatcher {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	c := newConfig()
+	for _, o := range opts {
+		c = o(c)
+	}
+	return &JobWatcher{
+		notifier: notifier,
+		interval: interval,
+		clock:    c.clock,
+		pending:  make(map[string]JobPoller),
+	}
+}
+
+// Watch adds job polled with poller to watched jobs, it could be called concurrently with Run.
+// Job ids must be unique across watched jobs, mapbox upload, tileset and routing problem job ids are.
+func (w *JobWatcher) Watch(poller JobPoller, id string) {
+	w.mu.Lock()
+	w.pending[id] = poller
+	w.mu.Unlock()
+}
+
+// Pending returns number of watched jobs which are not finished yet.
+func (w *JobWatcher) Pending() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.pending)
+}
+
+// Run polls watched jobs every interval until ctx is done or a polled client is closed.
+// Jobs are polled again on the next tick if status request or notification fails.
+func (w *JobWatcher) Run(ctx context.Context) error {
+	for {
+		if err := w.poll(ctx); err != nil {
+			return err
+		}
+
+		if err := w.clock.Sleep(ctx, w.interval); err != nil {
+			return err
+		}
+	}
+}
+
+// poll returns ErrClosed only, other errors are retried on the next tick.
+func (w *JobWatcher) poll(ctx context.Context) error {
+	w.mu.Lock()
+	pending := make(map[string]JobPoller, len(w.pending))
+	for id, poller := range w.pending {
+		pending[id] = poller
+	}
+	w.mu.Unlock()
+
+	for id, poller := range pending {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		event, err := poller.PollJob(ctx, id)
+		if errors.Is(err, ErrClosed) {
+			return err
+		}
+		if err != nil || event == nil {
+			continue
+		}
+
+		if err := w.notifier.Notify(ctx, event); err != nil {
+			continue
+		}
+
+		w.mu.Lock()
+		delete(w.pending, id)
+		w.mu.Unlock()
+	}
+
+	return nil
+}
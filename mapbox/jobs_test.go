@@ -0,0 +1,167 @@
+package mapbox
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestJobWatcher_poll(t *testing.T) {
+	uploads := NewFastHttpUploads(AccessToken("token"), Username("user"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			switch id := uri[strings.LastIndex(uri, "/")+1 : strings.Index(uri, "?")]; id {
+			case "done":
+				resp.SetBodyString(`{"id":"done","complete":true,"progress":1}`)
+			case "failed":
+				resp.SetBodyString(`{"id":"failed","error":"invalid geojson"}`)
+			default:
+				resp.SetBodyString(`{"id":"running","progress":0.5}`)
+			}
+			return nil
+		})))
+
+	var webhookBody string
+	webhook := NewWebhookNotifier("https://example.com/hook", fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			webhookBody = string(req.Body())
+			return nil
+		}))
+
+	var events []*JobEvent
+	w := NewJobWatcher(JobNotifierFunc(func(ctx context.Context, event *JobEvent) error {
+		events = append(events, event)
+		return webhook.Notify(ctx, event)
+	}), 0)
+
+	w.Watch(UploadPoller{Uploads: uploads}, "done")
+	w.Watch(UploadPoller{Uploads: uploads}, "running")
+	w.Watch(UploadPoller{Uploads: uploads}, "failed")
+
+	w.poll(context.Background())
+
+	if w.Pending() != 1 {
+		t.Errorf("unexpected pending %d", w.Pending())
+	}
+	if len(events) != 2 {
+		t.Fatalf("unexpected events %v", events)
+	}
+	for _, e := range events {
+		if e.Kind != JobKindUpload || e.Upload == nil || (e.ID == "failed") != (e.Error != "") {
+			t.Errorf("unexpected event %+v", e)
+		}
+	}
+	if !strings.HasPrefix(webhookBody, `{"kind":"upload","id":`) {
+		t.Errorf("unexpected webhook body %s", webhookBody)
+	}
+}
+
+func TestJobWatcher_pollTilesetsAndRoutingProblems(t *testing.T) {
+	client := HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			switch {
+			case strings.Contains(uri, "/jobs/published?"):
+				resp.SetBodyString(`{"id":"published","stage":"success","tilesetId":"user.ts"}`)
+			case strings.Contains(uri, "/jobs/broken?"):
+				resp.SetBodyString(`{"id":"broken","stage":"failed","tilesetId":"user.ts","errors":["invalid recipe"]}`)
+			case strings.Contains(uri, "/jobs/tiling?"):
+				resp.SetBodyString(`{"id":"tiling","stage":"processing","tilesetId":"user.ts"}`)
+			case strings.Contains(uri, "/optimized-trips/v2/solved?"):
+				resp.SetBodyString(`{"dropped":{"services":[],"shipments":[]},"routes":[{"vehicle":"van","stops":[]}]}`)
+			case strings.Contains(uri, "/optimized-trips/v2/solving?"):
+				resp.SetStatusCode(http.StatusAccepted)
+				resp.SetBodyString(`{"status":"processing"}`)
+			default:
+				t.Errorf("unexpected request %s", uri)
+			}
+			return nil
+		}))
+	tilesets := TilesetJobPoller{Tilesets: NewFastHttpTilesets(AccessToken("token"), client), TilesetID: "user.ts"}
+	optimization := RoutingProblemPoller{Optimization: NewFastHttpOptimization(AccessToken("token"), client)}
+
+	events := map[string]*JobEvent{}
+	w := NewJobWatcher(JobNotifierFunc(func(ctx context.Context, event *JobEvent) error {
+		events[event.ID] = event
+		return nil
+	}), 0)
+
+	w.Watch(tilesets, "published")
+	w.Watch(tilesets, "broken")
+	w.Watch(tilesets, "tiling")
+	w.Watch(optimization, "solved")
+	w.Watch(optimization, "solving")
+
+	if err := w.poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if w.Pending() != 2 || len(events) != 3 {
+		t.Fatalf("pending = %d, events %v", w.Pending(), events)
+	}
+	if e := events["published"]; e.Kind != JobKindTileset || e.Error != "" || e.TilesetJob.Stage != TilesetJobSuccess {
+		t.Errorf("unexpected published event %+v", e)
+	}
+	if e := events["broken"]; e.Kind != JobKindTileset || e.Error == "" || len(e.TilesetJob.Errors) != 1 {
+		t.Errorf("unexpected failed event %+v", e)
+	}
+	if e := events["solved"]; e.Kind != JobKindRoutingProblem || e.Solution == nil || len(e.Solution.Routes) != 1 {
+		t.Errorf("unexpected solution event %+v", e)
+	}
+}
+
+// cancelClock cancels polling after n sleeps.
+type cancelClock struct {
+	fakeClock
+	n      int
+	cancel context.CancelFunc
+}
+
+func (c *cancelClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.fakeClock.Sleep(ctx, d)
+	if len(c.sleeps) >= c.n {
+		c.cancel()
+		return ctx.Err()
+	}
+	return nil
+}
+
+func TestJobWatcher_Run(t *testing.T) {
+	polls := 0
+	poller := jobPollerFunc(func(ctx context.Context, id string) (*JobEvent, error) {
+		polls++
+		if polls < 3 {
+			return nil, nil
+		}
+		return &JobEvent{Kind: JobKindUpload, ID: id}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	clock := &cancelClock{fakeClock: fakeClock{now: time.Unix(1000, 0)}, n: 3, cancel: cancel}
+	var events []*JobEvent
+	w := NewJobWatcher(JobNotifierFunc(func(ctx context.Context, event *JobEvent) error {
+		events = append(events, event)
+		return nil
+	}), time.Minute, WithClock(clock))
+	w.Watch(poller, "upload")
+
+	if err := w.Run(ctx); err != context.Canceled {
+		t.Errorf("Run() error = %v, want context.Canceled", err)
+	}
+	if polls != 3 || len(events) != 1 || w.Pending() != 0 {
+		t.Errorf("polls = %d, events %v, pending %d", polls, events, w.Pending())
+	}
+	if len(clock.sleeps) != 3 || clock.sleeps[0] != time.Minute {
+		t.Errorf("unexpected sleeps %v", clock.sleeps)
+	}
+}
+
+type jobPollerFunc func(ctx context.Context, id string) (*JobEvent, error)
+
+func (f jobPollerFunc) PollJob(ctx context.Context, id string) (*JobEvent, error) {
+	return f(ctx, id)
+}
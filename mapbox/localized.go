@@ -0,0 +1,140 @@
+package mapbox
+
+import (
+	"context"
+	"sync"
+)
+
+// LocalizedName is a feature name in a single language.
+type LocalizedName struct {
+	Text      string
+	PlaceName string
+}
+
+// LocalizedFeature is a feature with names in all requested languages it was found in.
+type LocalizedFeature struct {
+	// Feature is the one of the first language response it was found in.
+	Feature Feature
+	// Names are keyed by language.
+	Names map[string]LocalizedName
+}
+
+// LocalizedGeocodeResponse wraps features merged from per language responses.
+type LocalizedGeocodeResponse struct {
+	// RateLimit of the last response.
+	RateLimit RateLimit
+	// Features are ordered as in the first language response,
+	// features missing there are appended in order of the following responses.
+	Features []LocalizedFeature
+}
+
+// ForwardGeocodeLanguages forward geocodes req once per language and merges localized names by feature ID,
+// e.g. to store names of a place in every supported locale. req.Language is ignored.
+// It fails if any of the calls fails.
+func (c *FastHttpGeocoder) ForwardGeocodeLanguages(ctx context.Context, req *ForwardGeocodeRequest,
+	languages []string, opts ...CallOption) (*LocalizedGeocodeResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if len(languages) == 0 {
+		return nil, validationErrorf("languages", ConstraintMinItems, "at least one language is required")
+	}
+
+	resp := &LocalizedGeocodeResponse{}
+	byID := make(map[string]int)
+
+	for _, l := range languages {
+		localized := *req
+		localized.Language = l
+
+		r, err := c.ForwardGeocode(ctx, &localized)
+		if err != nil {
+			return nil, errorf("failed to forward geocode language %s: %w", l, err)
+		}
+		resp.RateLimit = r.RateLimit
+
+		for _, f := range r.Features {
+			i, ok := byID[f.ID]
+			if !ok {
+				i = len(resp.Features)
+				byID[f.ID] = i
+				resp.Features = append(resp.Features, LocalizedFeature{
+					Feature: f,
+					Names:   make(map[string]LocalizedName, len(languages)),
+				})
+			}
+			resp.Features[i].Names[l] = LocalizedName{Text: f.Text, PlaceName: f.PlaceName}
+		}
+	}
+
+	return resp, nil
+}
+
+// LocalizedDirectionsResponse wraps route steps of per language responses.
+type LocalizedDirectionsResponse struct {
+	// RateLimit of the first language response.
+	RateLimit RateLimit
+	// Route is the first route of the first language response.
+	Route Route
+	// Steps are the first route steps keyed by language and then by leg index.
+	Steps map[string][][]RouteStep
+}
+
+// DirectionsLanguages requests directions with steps once per language in parallel
+// and collects the first route instructions of every language, e.g. to render notifications in every user locale.
+// req.Language is ignored and steps are always requested. It fails if any of the calls fails or finds no route.
+func (c *FastHttpDirections) DirectionsLanguages(ctx context.Context, req *DirectionsRequest,
+	languages []string, opts ...CallOption) (*LocalizedDirectionsResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if len(languages) == 0 {
+		return nil, validationErrorf("languages", ConstraintMinItems, "at least one language is required")
+	}
+
+	responses := make([]*DirectionsResponse, len(languages))
+	errs := make([]error, len(languages))
+	wg := sync.WaitGroup{}
+
+	for i, l := range languages {
+		localized := *req
+		localized.Language = l
+		localized.Steps = true
+
+		wg.Add(1)
+		go func(i int, l string) {
+			defer wg.Done()
+
+			r, err := c.Directions(ctx, &localized)
+			if err == nil && len(r.Routes) == 0 {
+				err = errorf("no route found, code %s", r.Code)
+			}
+			if err != nil {
+				errs[i] = errorf("failed to get directions language %s: %w", l, err)
+				return
+			}
+			responses[i] = r
+		}(i, l)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &LocalizedDirectionsResponse{
+		RateLimit: responses[0].RateLimit,
+		Route:     responses[0].Routes[0],
+		Steps:     make(map[string][][]RouteStep, len(languages)),
+	}
+	for i, l := range languages {
+		legs := responses[i].Routes[0].Legs
+		steps := make([][]RouteStep, len(legs))
+		for j := range legs {
+			steps[j] = legs[j].Steps
+		}
+		resp.Steps[l] = steps
+	}
+
+	return resp, nil
+}
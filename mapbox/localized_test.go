@@ -0,0 +1,83 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpGeocoder_ForwardGeocodeLanguages(t *testing.T) {
+	bodies := map[string]string{
+		"en": `{"type":"FeatureCollection","query":["germany"],"features":[{"id":"country.1","text":"Germany","place_name":"Germany"}]}`,
+		"de": `{"type":"FeatureCollection","query":["germany"],"features":[{"id":"country.1","text":"Deutschland","place_name":"Deutschland"},{"id":"place.2","text":"Germania","place_name":"Germania"}]}`,
+	}
+	g := NewFastHttpGeocoder(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			resp.SetBodyString(bodies[uri[strings.Index(uri, "language=")+len("language="):][:2]])
+			return nil
+		})))
+
+	resp, err := g.ForwardGeocodeLanguages(context.Background(), &ForwardGeocodeRequest{SearchText: "germany"}, []string{"en", "de"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Features) != 2 {
+		t.Fatalf("unexpected features %+v", resp.Features)
+	}
+	country := resp.Features[0]
+	if country.Feature.Text != "Germany" || country.Names["en"].Text != "Germany" || country.Names["de"].PlaceName != "Deutschland" {
+		t.Errorf("unexpected country %+v", country)
+	}
+	if place := resp.Features[1]; place.Feature.ID != "place.2" || len(place.Names) != 1 || place.Names["de"].Text != "Germania" {
+		t.Errorf("unexpected place %+v", place)
+	}
+
+	if _, err := g.ForwardGeocodeLanguages(context.Background(), &ForwardGeocodeRequest{SearchText: "germany"}, nil); err == nil {
+		t.Error("language required error expected")
+	}
+}
+
+func TestFastHttpDirections_DirectionsLanguages(t *testing.T) {
+	instructions := map[string]string{"en": "Turn left", "de": "Links abbiegen"}
+	d := NewFastHttpDirections(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			if !strings.Contains(uri, "steps=true") {
+				t.Errorf("steps expected in %s", uri)
+			}
+			l := uri[strings.Index(uri, "language=")+len("language="):][:2]
+			if l == "fr" {
+				resp.SetBodyString(`{"code":"NoRoute","routes":[]}`)
+				return nil
+			}
+			resp.SetBodyString(`{"code":"Ok","routes":[{"duration":60,"distance":500,"legs":[{"steps":[{"maneuver":{"instruction":"` +
+				instructions[l] + `"}}]}]}]}`)
+			return nil
+		})))
+
+	req := &DirectionsRequest{Profile: ProfileDriving, Coordinates: []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}}}
+	resp, err := d.DirectionsLanguages(context.Background(), req, []string{"en", "de"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Route.Distance != 500 || len(resp.Steps) != 2 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	for l, want := range instructions {
+		if steps := resp.Steps[l]; len(steps) != 1 || len(steps[0]) != 1 || steps[0][0].Maneuver.Instruction != want {
+			t.Errorf("steps[%s] = %+v, want %s", l, steps, want)
+		}
+	}
+	if req.Steps || req.Language != "" {
+		t.Error("request must not be changed")
+	}
+
+	if _, err := d.DirectionsLanguages(context.Background(), req, []string{"en", "fr"}); err == nil || !strings.Contains(err.Error(), "language fr") {
+		t.Errorf("DirectionsLanguages() error = %v, want no route of fr", err)
+	}
+}
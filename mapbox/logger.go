@@ -1,7 +1,9 @@
 package mapbox
 
 import (
+	"bytes"
 	"context"
+	"sort"
 )
 
 type Logger interface {
@@ -9,9 +11,54 @@ type Logger interface {
 	Errorf(msg string, params ...interface{})
 }
 
+// LogMode defines what is written to debug logs.
+type LogMode int
+
+const (
+	// LogModeFull logs full request URIs and response bodies.
+	LogModeFull LogMode = iota
+	// LogModeParams logs only endpoint and request parameters and response size.
+	// Access tokens, search texts and response bodies are never logged.
+	LogModeParams
+)
+
+const (
+	logKeyEndpoint      = "endpoint"
+	logKeyCoordinate    = "coordinate"
+	logKeySearchTextLen = "search_text_len"
+	logKeyUsername      = "username"
+	logKeyTilesets      = "tilesets"
+	logKeyUpload        = "upload"
+	logKeyDataset       = "dataset"
+	logKeyFeature       = "feature"
+	logKeyStyle         = "style"
+	logKeyBatchSize     = "batch_size"
+	logKeyCategory      = "category"
+	logKeyProfile       = "profile"
+	logKeyCoordinates   = "coordinates"
+	logKeyVehicles      = "vehicles"
+	logKeyLocations     = "locations"
+	logKeyJobID         = "job_id"
+	logKeyTileset       = "tileset"
+	logKeySource        = "source"
+)
+
+// DebugLogMode sets what is written to debug logs, default to LogModeFull.
+func DebugLogMode(m LogMode) Option {
+	return func(c config) config {
+		c.logMode = m
+		return c
+	}
+}
+
 // withLogger helps to reduce unnecessary allocations
 func (c *config) withLogger(ctx context.Context, do func(Logger)) {
-	if c.requestLogger != nil  {
+	if l := contextLogger(ctx); l != nil {
+		do(l)
+		return
+	}
+
+	if c.requestLogger != nil {
 		do(c.requestLogger(ctx))
 		return
 	}
@@ -19,4 +66,55 @@ func (c *config) withLogger(ctx context.Context, do func(Logger)) {
 	if c.logger != nil {
 		do(c.logger)
 	}
-}
\ No newline at end of file
+}
+
+// logRequest logs request URI or only its params depending on log mode.
+// extra are additional key, value pairs which are not a part of query params, e.g. coordinates from path.
+func (c *config) logRequest(ctx context.Context, op string, reqURI []byte, params map[string]string, extra ...string) {
+	c.withLogger(ctx, func(logger Logger) {
+		if c.logMode == LogModeParams {
+			logger.Debugf("mapbox_sdk: %s request %s", op, formatLogParams(params, extra...))
+			return
+		}
+		logger.Debugf("mapbox_sdk: %s request %s", op, string(reqURI))
+	})
+}
+
+// logResponse logs response body or only its size depending on log mode.
+func (c *config) logResponse(ctx context.Context, op string, statusCode int, requestID string, body []byte) {
+	c.withLogger(ctx, func(logger Logger) {
+		if c.logMode == LogModeParams {
+			logger.Debugf("mapbox_sdk: %s response status=%d request_id=%s bytes=%d", op, statusCode, requestID, len(body))
+			return
+		}
+		logger.Debugf("mapbox_sdk: %s response request_id=%s %s", op, requestID, string(body))
+	})
+}
+
+// formatLogParams formats extra pairs and params as space separated key=value pairs, params are sorted by key.
+func formatLogParams(params map[string]string, extra ...string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	buf := bytes.Buffer{}
+	for i := 0; i+1 < len(extra); i += 2 {
+		writeLogParam(&buf, extra[i], extra[i+1])
+	}
+	for _, k := range keys {
+		writeLogParam(&buf, k, params[k])
+	}
+
+	return buf.String()
+}
+
+func writeLogParam(buf *bytes.Buffer, k, v string) {
+	if buf.Len() > 0 {
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(k)
+	buf.WriteByte(equalMark)
+	buf.WriteString(v)
+}
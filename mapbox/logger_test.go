@@ -12,6 +12,7 @@ func Test_config_withLogger(t *testing.T) {
 		name   string
 		logger        func(mc *minimock.Controller) Logger
 		requestLogger func(mc *minimock.Controller) func(context.Context) Logger
+		ctxLogger     func(mc *minimock.Controller) Logger
 	}{
 		{
 			name:"testLogger set",
@@ -45,6 +46,23 @@ func Test_config_withLogger(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "context logger set",
+			logger: func(mc *minimock.Controller) Logger {
+				return NewLoggerMock(mc)
+			},
+			requestLogger: func(mc *minimock.Controller) func(context.Context) Logger {
+				mock := NewLoggerMock(mc)
+				return func(context.Context) Logger {
+					return mock
+				}
+			},
+			ctxLogger: func(mc *minimock.Controller) Logger {
+				mock := NewLoggerMock(mc)
+				mock.DebugfMock.Return()
+				return mock
+			},
+		},
 
 	}
 	for _, tt := range tests {
@@ -57,10 +75,47 @@ func Test_config_withLogger(t *testing.T) {
 			if tt.requestLogger != nil {
 				c.requestLogger = tt.requestLogger(mc)
 			}
-			c.withLogger(context.Background(), func(l Logger) {
+			ctx := context.Background()
+			if tt.ctxLogger != nil {
+				ctx = WithContextLogger(ctx, tt.ctxLogger(mc))
+			}
+			c.withLogger(ctx, func(l Logger) {
 				l.Debugf("")
 			})
 			mc.Finish()
 		})
 	}
 }
+
+func Test_config_logRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		logMode LogMode
+		want    string
+	}{
+		{
+			name:    "full",
+			logMode: LogModeFull,
+			want:    "/geocoding/v5/mapbox.places/1,2.json?access_token=token&limit=1",
+		},
+		{
+			name:    "params",
+			logMode: LogModeParams,
+			want:    "endpoint=mapbox.places coordinate=1,2 limit=1 types=address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			logger := NewLoggerMock(mc)
+			logger.DebugfMock.Expect("mapbox_sdk: %s request %s", "reverse geocode", tt.want).Return()
+
+			c := config{logger: logger, logMode: tt.logMode}
+			c.logRequest(context.Background(), "reverse geocode",
+				[]byte("/geocoding/v5/mapbox.places/1,2.json?access_token=token&limit=1"),
+				map[string]string{types: "address", limit: "1"},
+				logKeyEndpoint, "mapbox.places", logKeyCoordinate, "1,2")
+			mc.Finish()
+		})
+	}
+}
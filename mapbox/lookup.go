@@ -0,0 +1,66 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrNotFound is returned by lookup helpers if mapbox has no matching feature.
+var ErrNotFound = errors.New("feature not found")
+
+// GeocodePostcode forward geocodes postal code within country, an ISO 3166 alpha 2 code,
+// and returns postcode feature with its Center and BoundingBox.
+func (c *FastHttpGeocoder) GeocodePostcode(ctx context.Context, code, country string, opts ...CallOption) (*Feature, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if code == "" {
+		return nil, validationErrorf("code", ConstraintRequired, "postcode and country are required")
+	}
+	if country == "" {
+		return nil, validationErrorf("country", ConstraintRequired, "postcode and country are required")
+	}
+
+	off := false
+	resp, err := c.ForwardGeocode(ctx, &ForwardGeocodeRequest{
+		SearchText:   code,
+		Country:      country,
+		Types:        []string{PlaceTypePostcode},
+		Limit:        1,
+		Autocomplete: &off,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Features {
+		if resp.Features[i].HasPlaceType(PlaceTypePostcode) {
+			return &resp.Features[i], nil
+		}
+	}
+
+	return nil, errorf("postcode %s country %s: %w", code, country, ErrNotFound)
+}
+
+// LookupFeature forward geocodes stored feature ID, e.g. place.7673410831246050,
+// and returns the canonical feature, so stored IDs could be re-resolved to current names and hierarchy.
+func (c *FastHttpGeocoder) LookupFeature(ctx context.Context, id string, opts ...CallOption) (*Feature, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if i := strings.IndexByte(id, '.'); i <= 0 || i == len(id)-1 {
+		return nil, validationErrorf("id", ConstraintFormat, "invalid feature id %q", id)
+	}
+
+	resp, err := c.ForwardGeocode(ctx, &ForwardGeocodeRequest{SearchText: id})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Features {
+		if resp.Features[i].ID == id {
+			return &resp.Features[i], nil
+		}
+	}
+
+	return nil, errorf("feature id %s: %w", id, ErrNotFound)
+}
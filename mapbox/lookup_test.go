@@ -0,0 +1,68 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpGeocoder_GeocodePostcode(t *testing.T) {
+	var uri string
+	g := NewFastHttpGeocoder(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			if strings.Contains(uri, "/00000.json") {
+				resp.SetBodyString(`{"type":"FeatureCollection","query":["00000"],"features":[]}`)
+				return nil
+			}
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["20024"],"features":[{"id":"postcode.4419139247733840",
+				"place_type":["postcode"],"text":"20024","center":[-77.03,38.89],"bbox":[-77.06,38.85,-77.0,38.89]}]}`)
+			return nil
+		})))
+
+	f, err := g.GeocodePostcode(context.Background(), "20024", "us")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Text != "20024" || len(f.Center) != 2 || len(f.BoundingBox) != 4 {
+		t.Errorf("unexpected feature %+v", f)
+	}
+	if want := "/20024.json?access_token=token&autocomplete=false&country=us&fuzzymatch=true&limit=1&routing=false&types=postcode"; !strings.HasSuffix(uri, want) {
+		t.Errorf("uri = %s, want suffix %s", uri, want)
+	}
+
+	if _, err := g.GeocodePostcode(context.Background(), "00000", "us"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ErrNotFound expected, got %v", err)
+	}
+}
+
+func TestFastHttpGeocoder_LookupFeature(t *testing.T) {
+	g := NewFastHttpGeocoder(HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		if !strings.Contains(string(req.RequestURI()), "/place.7673410831246050.json") {
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["place.1"],"features":[]}`)
+			return nil
+		}
+		resp.SetBodyString(`{"type":"FeatureCollection","query":["place.7673410831246050"],"features":[
+			{"id":"place.7673410831246050","place_type":["place"],"text":"Washington",
+			"context":[{"id":"country.9053006287256050","short_code":"us","text":"United States"}]}]}`)
+		return nil
+	})))
+
+	f, err := g.LookupFeature(context.Background(), "place.7673410831246050")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if country, ok := f.Country(); f.Text != "Washington" || !ok || country.ShortCode != "us" {
+		t.Errorf("unexpected feature %+v", f)
+	}
+
+	if _, err := g.LookupFeature(context.Background(), "place.1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ErrNotFound expected, got %v", err)
+	}
+	if _, err := g.LookupFeature(context.Background(), "washington"); err == nil {
+		t.Error("invalid id error expected")
+	}
+}
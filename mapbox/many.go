@@ -0,0 +1,250 @@
+package mapbox
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultManyConcurrency = 4
+	defaultManyMaxRetries  = 3
+	defaultManyBackoff     = time.Second
+)
+
+// ManyOption tunes ReverseGeocodeMany and BatchGeocodeMany.
+type ManyOption func(o manyOptions) manyOptions
+
+type manyOptions struct {
+	concurrency int
+	template    ReverseGeocodeRequest
+	maxRetries  int
+	backoff     time.Duration
+}
+
+func newManyOptions(opts []ManyOption) manyOptions {
+	o := manyOptions{concurrency: defaultManyConcurrency}
+	for _, opt := range opts {
+		o = opt(o)
+	}
+	if o.concurrency <= 0 {
+		o.concurrency = defaultManyConcurrency
+	}
+	switch {
+	case o.maxRetries == 0:
+		o.maxRetries = defaultManyMaxRetries
+	case o.maxRetries < 0:
+		o.maxRetries = 0
+	}
+	if o.backoff <= 0 {
+		o.backoff = defaultManyBackoff
+	}
+	return o
+}
+
+// ManyConcurrency sets a max number of in-flight requests, default 4.
+func ManyConcurrency(n int) ManyOption {
+	return func(o manyOptions) manyOptions {
+		o.concurrency = n
+		return o
+	}
+}
+
+// ManyRetries sets a max number of retries of a BatchGeocodeMany batch on 429 Too Many Requests, default 3,
+// negative disables retries. backoff is an initial delay between retries, doubled each retry, default 1s,
+// X-Rate-Limit-Reset response header is preferred if present.
+func ManyRetries(maxRetries int, backoff time.Duration) ManyOption {
+	return func(o manyOptions) manyOptions {
+		o.maxRetries = maxRetries
+		o.backoff = backoff
+		return o
+	}
+}
+
+// ManyRequest sets ReverseGeocodeMany request params used for every point, its GeoPoint is ignored.
+func ManyRequest(req ReverseGeocodeRequest) ManyOption {
+	return func(o manyOptions) manyOptions {
+		o.template = req
+		return o
+	}
+}
+
+// ReverseGeocodeResult is a single point result of ReverseGeocodeMany, either Response or Err is set.
+type ReverseGeocodeResult struct {
+	Response *GeocodeResponse
+	Err      error
+}
+
+// ReverseGeocodeManyResponse wraps ReverseGeocodeMany results.
+type ReverseGeocodeManyResponse struct {
+	// RateLimit is the one with the latest reset of all responses.
+	RateLimit RateLimit
+	// Results are in points order.
+	Results []ReverseGeocodeResult
+}
+
+// Failed returns number of points which failed to geocode.
+func (r *ReverseGeocodeManyResponse) Failed() int {
+	failed := 0
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// ReverseGeocodeMany reverse geocodes points with bounded concurrency.
+// It never fails as a whole, every point error is reported in its result, points not started before ctx is done
+// fail with ctx error.
+func (c *FastHttpGeocoder) ReverseGeocodeMany(ctx context.Context, points []GeoPoint, opts ...ManyOption) *ReverseGeocodeManyResponse {
+	o := newManyOptions(opts)
+
+	resp := &ReverseGeocodeManyResponse{Results: make([]ReverseGeocodeResult, len(points))}
+	var latestReset int64
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, o.concurrency)
+
+	for i := range points {
+		i := i
+
+		if err := ctx.Err(); err != nil {
+			resp.Results[i].Err = err
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			resp.Results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			req := o.template
+			req.GeoPoint = points[i]
+			r, err := c.ReverseGeocode(ctx, &req)
+			resp.Results[i] = ReverseGeocodeResult{Response: r, Err: err}
+			if err != nil {
+				return
+			}
+
+			reset, _ := strconv.ParseInt(string(r.RateLimit.Reset), 10, 64)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if reset >= latestReset {
+				latestReset = reset
+				resp.RateLimit = r.RateLimit
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return resp
+}
+
+// BatchGeocodeResult is a single query result of BatchGeocodeMany, either Features or Err is set.
+type BatchGeocodeResult struct {
+	Features []FeatureV6
+	// Permanent is whether the query batch was geocoded with the permanent endpoint.
+	Permanent bool
+	Err       error
+}
+
+// BatchGeocodeManyResponse wraps BatchGeocodeMany results.
+type BatchGeocodeManyResponse struct {
+	// RateLimit is the one with the latest reset of all responses.
+	RateLimit RateLimit
+	// Results are in queries order.
+	Results []BatchGeocodeResult
+}
+
+// Failed returns number of queries which failed to geocode.
+func (r *BatchGeocodeManyResponse) Failed() int {
+	failed := 0
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// BatchGeocodeMany splits any number of queries into BatchGeocode batches of MaxBatchGeocodeQueries
+// and runs them with bounded concurrency, batches rejected with 429 Too Many Requests are retried.
+// It never fails as a whole, every query of a failed batch gets the batch error in its result, batches
+// not started before ctx is done fail with ctx error.
+func (c *FastHttpGeocoderV6) BatchGeocodeMany(ctx context.Context, reqs []ForwardGeocodeV6Request, opts ...ManyOption) *BatchGeocodeManyResponse {
+	o := newManyOptions(opts)
+
+	resp := &BatchGeocodeManyResponse{Results: make([]BatchGeocodeResult, len(reqs))}
+	var latestReset int64
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, o.concurrency)
+
+	fail := func(from, to int, err error) {
+		for i := from; i < to; i++ {
+			resp.Results[i].Err = err
+		}
+	}
+
+	for from := 0; from < len(reqs); from += MaxBatchGeocodeQueries {
+		from, to := from, from+MaxBatchGeocodeQueries
+		if to > len(reqs) {
+			to = len(reqs)
+		}
+
+		if err := ctx.Err(); err != nil {
+			fail(from, to, err)
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			fail(from, to, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			r, err := c.batchGeocode(ctx, reqs[from:to], o.maxRetries, o.backoff)
+			if err != nil {
+				fail(from, to, err)
+				return
+			}
+			for i, features := range r.Results {
+				resp.Results[from+i] = BatchGeocodeResult{Features: features, Permanent: r.Permanent}
+			}
+
+			reset, _ := strconv.ParseInt(string(r.RateLimit.Reset), 10, 64)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if reset >= latestReset {
+				latestReset = reset
+				resp.RateLimit = r.RateLimit
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return resp
+}
@@ -0,0 +1,128 @@
+package mapbox
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpGeocoder_ReverseGeocodeMany(t *testing.T) {
+	var inFlight, maxInFlight int32
+	g := NewFastHttpGeocoder(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+
+			uri := string(req.RequestURI())
+			if strings.Contains(uri, "/3.000000,3.000000.json") {
+				resp.SetStatusCode(fasthttp.StatusInternalServerError)
+				return nil
+			}
+			reset := "100"
+			if strings.Contains(uri, "/2.000000,2.000000.json") {
+				reset = "200"
+			}
+			resp.Header.Set(respHeaderRateLimitReset, reset)
+			resp.Header.Set(respHeaderRateLimitLimit, "600")
+			resp.SetBody(testRespBody)
+			return nil
+		})))
+
+	points := []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}, {Lon: 3, Lat: 3}, {Lon: 4, Lat: 4}}
+	resp := g.ReverseGeocodeMany(context.Background(), points, ManyConcurrency(2), ManyRequest(ReverseGeocodeRequest{Limit: 1}))
+
+	if len(resp.Results) != len(points) || resp.Failed() != 1 {
+		t.Fatalf("unexpected results %+v", resp.Results)
+	}
+	for i, res := range resp.Results {
+		if (res.Err != nil) != (i == 2) {
+			t.Errorf("result %d unexpected error %v", i, res.Err)
+		}
+	}
+	if string(resp.RateLimit.Reset) != "200" || string(resp.RateLimit.Limit) != "600" {
+		t.Errorf("unexpected rate limit %+v", resp.RateLimit)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("max in flight = %d, want at most 2", maxInFlight)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp = g.ReverseGeocodeMany(ctx, points, ManyConcurrency(1))
+	if resp.Failed() != len(points) {
+		t.Errorf("failed = %d, want all points canceled", resp.Failed())
+	}
+}
+
+func TestFastHttpGeocoderV6_BatchGeocodeMany(t *testing.T) {
+	var throttled int32
+	g := NewFastHttpGeocoderV6(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			var queries []struct {
+				Q string `json:"q"`
+			}
+			if err := json.Unmarshal(req.Body(), &queries); err != nil {
+				return err
+			}
+
+			switch queries[0].Q {
+			case "q1000":
+				resp.SetStatusCode(fasthttp.StatusInternalServerError)
+				return nil
+			case "q2000":
+				if atomic.AddInt32(&throttled, 1) == 1 {
+					resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+					return nil
+				}
+			}
+
+			batch := make([]string, len(queries))
+			for i, q := range queries {
+				batch[i] = `{"type":"FeatureCollection","features":[{"type":"Feature","properties":{"name":"` + q.Q + `"}}]}`
+			}
+			resp.Header.Set(respHeaderRateLimitReset, "100")
+			resp.SetBodyString(`{"batch":[` + strings.Join(batch, ",") + `]}`)
+			return nil
+		})))
+
+	reqs := make([]ForwardGeocodeV6Request, 2*MaxBatchGeocodeQueries+500)
+	for i := range reqs {
+		reqs[i].Query = "q" + strconv.Itoa(i)
+	}
+
+	resp := g.BatchGeocodeMany(context.Background(), reqs, ManyConcurrency(2), ManyRetries(1, time.Millisecond))
+
+	if len(resp.Results) != len(reqs) || resp.Failed() != MaxBatchGeocodeQueries {
+		t.Fatalf("results = %d, failed = %d", len(resp.Results), resp.Failed())
+	}
+	for i, res := range resp.Results {
+		failed := i >= MaxBatchGeocodeQueries && i < 2*MaxBatchGeocodeQueries
+		if (res.Err != nil) != failed {
+			t.Fatalf("result %d unexpected error %v", i, res.Err)
+		}
+		if !failed && (len(res.Features) != 1 || res.Features[0].Properties.Name != reqs[i].Query) {
+			t.Fatalf("result %d unexpected features %+v", i, res.Features)
+		}
+	}
+	if throttled != 2 || string(resp.RateLimit.Reset) != "100" {
+		t.Errorf("throttled = %d, rate limit %+v", throttled, resp.RateLimit)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if resp = g.BatchGeocodeMany(ctx, reqs[:10]); resp.Failed() != 10 {
+		t.Errorf("canceled batches must fail, results %+v", resp.Results)
+	}
+}
@@ -0,0 +1,324 @@
+package mapbox
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	timestamps = "timestamps"
+	tidy       = "tidy"
+	gaps       = "gaps"
+
+	// maxMatchingRadius is a max radius in meters a trace coordinate could be snapped within.
+	maxMatchingRadius = 50
+)
+
+// Map matching response codes besides DirectionsCodeOk.
+const (
+	MatchingCodeNoMatch = "NoMatch"
+)
+
+// Trace gaps handling, see MapMatchingRequest.Gaps.
+const (
+	// GapsSplit starts a new matching after a large gap in time or distance between trace coordinates.
+	GapsSplit = "split"
+	// GapsIgnore matches the whole trace as one matching if possible.
+	GapsIgnore = "ignore"
+)
+
+// MapMatchingRequest describes matching/v5 request of a GPS trace.
+type MapMatchingRequest struct {
+	// Profile default to ProfileDriving.
+	Profile DirectionsProfile
+	// Coordinates of 2 to MaxMapMatchingCoordinates trace points.
+	Coordinates []GeoPoint
+	// Timestamps are unix times in seconds of every coordinate, they improve matching of noisy traces.
+	Timestamps []int64
+	// Radiuses in meters from 0 to 50 a coordinate could be snapped within, one per coordinate, mapbox default is 5.
+	// Zero items keep mapbox default.
+	Radiuses []float64
+	// Tidy removes clusters and re-samples the trace before matching.
+	Tidy bool
+	// Gaps is GapsSplit or GapsIgnore, mapbox default is GapsSplit, gaps in time are detected with Timestamps.
+	Gaps string
+
+	// Geometries format, mapbox default is GeometriesPolyline.
+	Geometries string
+	// Overview geometry detail level, mapbox default is OverviewSimplified.
+	Overview string
+	// Steps requests turn-by-turn instructions.
+	Steps bool
+	// Language of instructions, default to language set with WithContextLanguage.
+	Language string
+	// VoiceInstructions and BannerInstructions request turn-by-turn UI instructions on every step,
+	// they require Steps.
+	VoiceInstructions  bool
+	BannerInstructions bool
+	// VoiceUnits of voice instructions distances, e.g. VoiceUnitsMetric, mapbox default depends on Language.
+	VoiceUnits string
+	// Annotations requests per segment metadata returned in RouteLeg.Annotation, e.g. AnnotationSpeed.
+	// Segments are ones of the full geometry, so it should be requested with OverviewFull.
+	Annotations []string
+}
+
+// Matching is a route the trace is matched to, legs are routes between matched tracepoints.
+type Matching struct {
+	// Confidence from 0 to 1 that the matching is right.
+	Confidence float64       `json:"confidence"`
+	Duration   float64       `json:"duration"`
+	Distance   float64       `json:"distance"`
+	Weight     float64       `json:"weight"`
+	WeightName string        `json:"weight_name"`
+	Geometry   RouteGeometry `json:"geometry"`
+	Legs       []RouteLeg    `json:"legs"`
+
+	// FirstTracepoint and LastTracepoint are indexes of the first and the last request coordinates
+	// matched by the matching, -1 if there are none. Coordinates between them could still be nil outliers.
+	FirstTracepoint int `json:"-"`
+	LastTracepoint  int `json:"-"`
+}
+
+// Tracepoint is a trace coordinate snapped to a matching.
+type Tracepoint struct {
+	// MatchingsIndex is an index of the matching the tracepoint belongs to.
+	MatchingsIndex int `json:"matchings_index"`
+	// WaypointIndex is an index of the tracepoint in the matching waypoints.
+	WaypointIndex int `json:"waypoint_index"`
+	// AlternativesCount is a number of other roads the coordinate could be matched to.
+	AlternativesCount int `json:"alternatives_count"`
+	// Name of the street the coordinate snapped to.
+	Name string `json:"name"`
+	// Snapped location as lon,lat pair.
+	Location []float64 `json:"location"`
+	// Distance in meters between the trace coordinate and the snapped location.
+	Distance float64 `json:"distance"`
+}
+
+// GeoPoint returns snapped location.
+func (t Tracepoint) GeoPoint() GeoPoint {
+	if len(t.Location) < 2 {
+		return GeoPoint{}
+	}
+	return GeoPoint{Lon: t.Location[0], Lat: t.Location[1]}
+}
+
+// easyjson:json
+type rawMapMatchingResp struct {
+	Code        string        `json:"code"`
+	Message     string        `json:"message,omitempty"`
+	Matchings   []Matching    `json:"matchings"`
+	Tracepoints []*Tracepoint `json:"tracepoints"`
+}
+
+// MapMatchingResponse wraps trace matchings.
+type MapMatchingResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	// Code is DirectionsCodeOk or MatchingCodeNoMatch, Matchings are empty for the latter.
+	Code string
+	// Matchings are in trace order, there are several of them if the trace was split at gaps.
+	Matchings []Matching
+	// Tracepoints has an item for every request coordinate, it is nil for outliers which weren't matched.
+	Tracepoints []*Tracepoint
+}
+
+// MapMatching covers mapbox map matching API.
+type MapMatching interface {
+	// MapMatching calls matching/v5 mapbox API
+	MapMatching(ctx context.Context, req *MapMatchingRequest, opts ...CallOption) (*MapMatchingResponse, error)
+}
+
+// FastHttpMapMatching is a fasthttp MapMatching implementation
+type FastHttpMapMatching struct {
+	config
+
+	matchingAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// MapMatching calls matching/v5 mapbox API thought fasthttp client.
+func (c *FastHttpMapMatching) MapMatching(ctx context.Context, req *MapMatchingRequest, opts ...CallOption) (*MapMatchingResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := ValidateCoordinates(req.Coordinates, MaxMapMatchingCoordinates); err != nil {
+		return nil, err
+	}
+	if (req.VoiceInstructions || req.BannerInstructions) && !req.Steps {
+		return nil, validationErrorf("Steps", ConstraintDepends, "voice and banner instructions require steps")
+	}
+
+	profile := req.Profile
+	if profile == "" {
+		profile = ProfileDriving
+	}
+
+	values := make(map[string]string, 12)
+	if req.Tidy {
+		values[tidy] = trueStr
+	}
+	switch req.Gaps {
+	case "":
+	case GapsSplit, GapsIgnore:
+		values[gaps] = req.Gaps
+	default:
+		return nil, validationErrorf("Gaps", ConstraintEnum, "unknown gaps %q", req.Gaps)
+	}
+	if req.Geometries != "" {
+		values[geometries] = req.Geometries
+	}
+	if req.Overview != "" {
+		values[overview] = req.Overview
+	}
+	if req.Steps {
+		values[steps] = trueStr
+	}
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
+	}
+	if len(req.Annotations) > 0 {
+		values[annotations] = strings.Join(req.Annotations, ",")
+	}
+	if req.VoiceInstructions {
+		values[voiceInstructions] = trueStr
+	}
+	if req.BannerInstructions {
+		values[bannerInstructions] = trueStr
+	}
+	if req.VoiceUnits != "" {
+		values[voiceUnits] = req.VoiceUnits
+	}
+	if err := req.traceValues(values); err != nil {
+		return nil, err
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.matchingAPIURL.Write(buf, values, string(profile), slash, formatCoordinates(req.Coordinates))
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "map matching", reqURI, values, logKeyProfile, string(profile),
+		logKeyCoordinates, strconv.Itoa(len(req.Coordinates)))
+
+	resp, err := c.do(ctx, "map matching", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "map matching", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("map matching", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawMapMatchingResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall map matching resp %s: %w", string(resp.body), err)
+	}
+	if respRaw.Code != DirectionsCodeOk && respRaw.Code != MatchingCodeNoMatch {
+		return nil, errorf("failed to match trace code %s message %s", respRaw.Code, respRaw.Message)
+	}
+	setTraceRanges(respRaw.Matchings, respRaw.Tracepoints)
+	if req.Geometries == GeometriesPolyline6 {
+		for i := range respRaw.Matchings {
+			setMatchingPolyline6(&respRaw.Matchings[i])
+		}
+	}
+
+	return &MapMatchingResponse{
+		RateLimit:   resp.rateLimit,
+		Meta:        resp.meta,
+		RawResp:     resp.body,
+		Code:        respRaw.Code,
+		Matchings:   respRaw.Matchings,
+		Tracepoints: respRaw.Tracepoints,
+	}, nil
+}
+
+// setTraceRanges sets matchings trace index ranges from tracepoints.
+func setTraceRanges(matchings []Matching, tracepoints []*Tracepoint) {
+	for i := range matchings {
+		matchings[i].FirstTracepoint, matchings[i].LastTracepoint = -1, -1
+	}
+	for i, tp := range tracepoints {
+		if tp == nil || tp.MatchingsIndex < 0 || tp.MatchingsIndex >= len(matchings) {
+			continue
+		}
+		m := &matchings[tp.MatchingsIndex]
+		if m.FirstTracepoint < 0 {
+			m.FirstTracepoint = i
+		}
+		m.LastTracepoint = i
+	}
+}
+
+// setMatchingPolyline6 marks matching and step polylines as polyline6 ones, so LineString decodes them right.
+func setMatchingPolyline6(m *Matching) {
+	m.Geometry.Precision = polyline6Precision
+	for i := range m.Legs {
+		for j := range m.Legs[i].Steps {
+			m.Legs[i].Steps[j].Geometry.Precision = polyline6Precision
+		}
+	}
+}
+
+// traceValues validates per coordinate timestamps and radiuses and encodes them semicolon separated.
+func (req *MapMatchingRequest) traceValues(values map[string]string) error {
+	n := len(req.Coordinates)
+
+	if len(req.Timestamps) > 0 {
+		if len(req.Timestamps) != n {
+			return validationErrorf("Timestamps", ConstraintItems, "%s must have %d items, one per coordinate, got %d",
+				timestamps, n, len(req.Timestamps))
+		}
+		items := make([]string, n)
+		for i, ts := range req.Timestamps {
+			if i > 0 && ts < req.Timestamps[i-1] {
+				return validationErrorf(indexField("Timestamps", i), ConstraintRange, "timestamp %d is before the previous one", i)
+			}
+			items[i] = strconv.FormatInt(ts, 10)
+		}
+		values[timestamps] = strings.Join(items, ";")
+	}
+
+	if len(req.Radiuses) > 0 {
+		if len(req.Radiuses) != n {
+			return validationErrorf("Radiuses", ConstraintItems, "%s must have %d items, one per coordinate, got %d",
+				radiuses, n, len(req.Radiuses))
+		}
+		items := make([]string, n)
+		for i, r := range req.Radiuses {
+			if r < 0 || r > maxMatchingRadius || math.IsNaN(r) {
+				return validationErrorf(indexField("Radiuses", i), ConstraintRange, "invalid radius %d: %v, must be from 0 to %d",
+					i, r, maxMatchingRadius)
+			}
+			if r > 0 {
+				items[i] = strconv.FormatFloat(r, floatFormatNoExponent, -1, 64)
+			}
+		}
+		values[radiuses] = strings.Join(items, ";")
+	}
+
+	return nil
+}
+
+// NewFastHttpMapMatching creates fasthttp MapMatching client.
+func NewFastHttpMapMatching(opts ...Option) *FastHttpMapMatching {
+	c := FastHttpMapMatching{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.matchingAPIURL = c.endpointURL("/matching/v5/mapbox/")
+
+	return &c
+}
@@ -0,0 +1,147 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+const testMapMatchingRespBody = `{"code":"Ok","matchings":[{"confidence":0.92,"duration":60,"distance":500,"weight":65,"weight_name":"auto",
+"geometry":{"type":"LineString","coordinates":[[-77.05,38.889],[-77.045,38.889],[-77.04,38.889]]},
+"legs":[{"summary":"Constitution Avenue","duration":30,"distance":250,"weight":32,
+"annotation":{"speed":[8.3,8.3],"distance":[125,125]},
+"steps":[{"name":"Constitution Avenue","mode":"driving","duration":30,"distance":250,
+"geometry":{"type":"LineString","coordinates":[[-77.05,38.889],[-77.045,38.889]]},
+"maneuver":{"type":"depart","instruction":"Drive east.","location":[-77.05,38.889],"bearing_before":0,"bearing_after":90},
+"voiceInstructions":[{"distanceAlongGeometry":250,"announcement":"Drive east for 250 meters."}],
+"bannerInstructions":[{"distanceAlongGeometry":250,"primary":{"text":"Constitution Avenue","components":[{"text":"Constitution Avenue","type":"text"}]}}]}]},
+{"summary":"Constitution Avenue","duration":30,"distance":250,"weight":33,"steps":[]}]}],
+"tracepoints":[{"matchings_index":0,"waypoint_index":0,"alternatives_count":0,"name":"Constitution Avenue","location":[-77.05,38.889],"distance":2.1},
+null,
+{"matchings_index":0,"waypoint_index":1,"alternatives_count":1,"name":"Constitution Avenue","location":[-77.045,38.889],"distance":1.4},
+{"matchings_index":0,"waypoint_index":2,"alternatives_count":0,"name":"Constitution Avenue","location":[-77.04,38.889],"distance":0.8}]}`
+
+func TestFastHttpMapMatching_MapMatching(t *testing.T) {
+	var gotURI string
+	c := NewFastHttpMapMatching(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			gotURI = string(req.RequestURI())
+			resp.SetBodyString(testMapMatchingRespBody)
+			return nil
+		})))
+
+	resp, err := c.MapMatching(context.Background(), &MapMatchingRequest{
+		Coordinates: []GeoPoint{
+			{Lon: -77.05, Lat: 38.889}, {Lon: -77.047, Lat: 38.8895}, {Lon: -77.045, Lat: 38.889}, {Lon: -77.04, Lat: 38.889},
+		},
+		Timestamps:         []int64{1000, 1010, 1020, 1030},
+		Radiuses:           []float64{10, 0, 10, 25},
+		Geometries:         GeometriesGeoJSON,
+		Overview:           OverviewFull,
+		Steps:              true,
+		VoiceInstructions:  true,
+		BannerInstructions: true,
+		Annotations:        []string{AnnotationSpeed, AnnotationDistance},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "https://api.mapbox.com/matching/v5/mapbox/driving/" +
+		"-77.050000,38.889000;-77.047000,38.889500;-77.045000,38.889000;-77.040000,38.889000?access_token=token" +
+		"&annotations=speed,distance&banner_instructions=true&geometries=geojson&overview=full&radiuses=10;;10;25" +
+		"&steps=true&timestamps=1000;1010;1020;1030&voice_instructions=true"
+	if gotURI != want {
+		t.Errorf("URI = %s, want %s", gotURI, want)
+	}
+
+	if len(resp.Matchings) != 1 || resp.Matchings[0].Confidence != 0.92 || len(resp.Matchings[0].Legs) != 2 {
+		t.Fatalf("unexpected matchings %+v", resp.Matchings)
+	}
+	leg := resp.Matchings[0].Legs[0]
+	switch {
+	case leg.Annotation == nil || len(leg.Annotation.Speed) != 2:
+		t.Errorf("unexpected annotation %+v", leg.Annotation)
+	case len(leg.Steps) != 1 || len(leg.Steps[0].VoiceInstructions) != 1 || len(leg.Steps[0].BannerInstructions) != 1:
+		t.Errorf("unexpected steps %+v", leg.Steps)
+	case leg.Steps[0].BannerInstructions[0].Primary.Text != "Constitution Avenue":
+		t.Errorf("unexpected banner %+v", leg.Steps[0].BannerInstructions[0])
+	}
+	if len(resp.Matchings[0].Geometry.LineString()) != 3 {
+		t.Errorf("unexpected geometry %+v", resp.Matchings[0].Geometry)
+	}
+
+	if len(resp.Tracepoints) != 4 || resp.Tracepoints[1] != nil || resp.Tracepoints[2].GeoPoint() != (GeoPoint{Lon: -77.045, Lat: 38.889}) {
+		t.Errorf("unexpected tracepoints %+v", resp.Tracepoints)
+	}
+}
+
+func TestFastHttpMapMatching_Gaps(t *testing.T) {
+	var gotURI string
+	c := NewFastHttpMapMatching(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			gotURI = string(req.RequestURI())
+			resp.SetBodyString(`{"code":"Ok","matchings":[` +
+				`{"confidence":0.9,"duration":10,"distance":100,"weight":10,"weight_name":"auto","geometry":"","legs":[]},` +
+				`{"confidence":0.7,"duration":20,"distance":200,"weight":20,"weight_name":"auto","geometry":"","legs":[]}],` +
+				`"tracepoints":[null,` +
+				`{"matchings_index":0,"waypoint_index":0,"location":[1,1]},{"matchings_index":0,"waypoint_index":1,"location":[2,2]},` +
+				`null,{"matchings_index":1,"waypoint_index":0,"location":[4,4]},null,` +
+				`{"matchings_index":1,"waypoint_index":1,"location":[6,6]}]}`)
+			return nil
+		})))
+
+	trace := []GeoPoint{{Lon: 0, Lat: 0}, {Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}, {Lon: 3, Lat: 3}, {Lon: 4, Lat: 4}, {Lon: 5, Lat: 5}, {Lon: 6, Lat: 6}}
+	resp, err := c.MapMatching(context.Background(), &MapMatchingRequest{Coordinates: trace, Gaps: GapsSplit})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(gotURI, "&gaps=split") {
+		t.Errorf("unexpected uri %s", gotURI)
+	}
+	if len(resp.Matchings) != 2 {
+		t.Fatalf("unexpected matchings %+v", resp.Matchings)
+	}
+	for i, want := range [][2]int{{1, 2}, {4, 6}} {
+		if m := resp.Matchings[i]; m.FirstTracepoint != want[0] || m.LastTracepoint != want[1] {
+			t.Errorf("matching %d trace range = %d-%d, want %d-%d", i, m.FirstTracepoint, m.LastTracepoint, want[0], want[1])
+		}
+	}
+
+	if _, err := c.MapMatching(context.Background(), &MapMatchingRequest{Coordinates: trace, Gaps: "merge"}); err == nil {
+		t.Error("unknown gaps error expected")
+	}
+}
+
+func TestFastHttpMapMatching_Validation(t *testing.T) {
+	c := NewFastHttpMapMatching(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			t.Error("unexpected request")
+			return nil
+		})))
+	trace := []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}}
+
+	tests := []struct {
+		name      string
+		req       MapMatchingRequest
+		wantField string
+	}{
+		{"instructions without steps", MapMatchingRequest{Coordinates: trace, VoiceInstructions: true}, "Steps"},
+		{"timestamps count", MapMatchingRequest{Coordinates: trace, Timestamps: []int64{1}}, "Timestamps"},
+		{"timestamps order", MapMatchingRequest{Coordinates: trace, Timestamps: []int64{2, 1}}, "Timestamps[1]"},
+		{"radius", MapMatchingRequest{Coordinates: trace, Radiuses: []float64{5, 51}}, "Radiuses[1]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.MapMatching(context.Background(), &tt.req)
+			var verr *ValidationError
+			if !errors.As(err, &verr) || verr.Field != tt.wantField {
+				t.Errorf("MapMatching() error = %v, want %s field", err, tt.wantField)
+			}
+		})
+	}
+}
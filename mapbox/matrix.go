@@ -0,0 +1,319 @@
+package mapbox
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// MatrixCell is a single value of a matrix API result.
+// Reachable is false when mapbox returned null for the pair, i.e. no route was found.
+type MatrixCell struct {
+	Value     float64
+	Reachable bool
+}
+
+// matrix is a sources x destinations table shared by DurationMatrix and DistanceMatrix.
+type matrix struct {
+	cells [][]MatrixCell
+}
+
+// Rows returns number of sources.
+func (m matrix) Rows() int {
+	return len(m.cells)
+}
+
+// Cols returns number of destinations.
+func (m matrix) Cols() int {
+	if len(m.cells) == 0 {
+		return 0
+	}
+	return len(m.cells[0])
+}
+
+// At returns value from i-th source to j-th destination.
+// ok is false if the pair is unreachable or out of matrix bounds.
+func (m matrix) At(i, j int) (value float64, ok bool) {
+	if i < 0 || i >= len(m.cells) || j < 0 || j >= len(m.cells[i]) {
+		return 0, false
+	}
+	c := m.cells[i][j]
+	return c.Value, c.Reachable
+}
+
+// Row returns values from i-th source to all destinations.
+func (m matrix) Row(i int) []MatrixCell {
+	if i < 0 || i >= len(m.cells) {
+		return nil
+	}
+	row := make([]MatrixCell, len(m.cells[i]))
+	copy(row, m.cells[i])
+	return row
+}
+
+// Column returns values from all sources to j-th destination.
+func (m matrix) Column(j int) []MatrixCell {
+	if j < 0 || j >= m.Cols() {
+		return nil
+	}
+	col := make([]MatrixCell, 0, len(m.cells))
+	for _, row := range m.cells {
+		if j < len(row) {
+			col = append(col, row[j])
+		} else {
+			col = append(col, MatrixCell{})
+		}
+	}
+	return col
+}
+
+// UnmarshalEasyJSON reads matrix API array of arrays where unreachable pairs are null.
+func (m *matrix) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		m.cells = nil
+		return
+	}
+	m.cells = m.cells[:0]
+	in.Delim('[')
+	for !in.IsDelim(']') {
+		var row []MatrixCell
+		if in.IsNull() {
+			in.Skip()
+		} else {
+			in.Delim('[')
+			row = make([]MatrixCell, 0, 8)
+			for !in.IsDelim(']') {
+				var c MatrixCell
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					c.Value = in.Float64()
+					c.Reachable = true
+				}
+				row = append(row, c)
+				in.WantComma()
+			}
+			in.Delim(']')
+		}
+		m.cells = append(m.cells, row)
+		in.WantComma()
+	}
+	in.Delim(']')
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (m *matrix) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	m.UnmarshalEasyJSON(&r)
+	return r.Error()
+}
+
+// MarshalEasyJSON writes matrix back the same way mapbox returns it, with null for unreachable pairs.
+func (m matrix) MarshalEasyJSON(out *jwriter.Writer) {
+	if m.cells == nil {
+		out.RawString("null")
+		return
+	}
+	out.RawByte('[')
+	for i, row := range m.cells {
+		if i > 0 {
+			out.RawByte(',')
+		}
+		out.RawByte('[')
+		for j, c := range row {
+			if j > 0 {
+				out.RawByte(',')
+			}
+			if c.Reachable {
+				out.Float64(c.Value)
+			} else {
+				out.RawString("null")
+			}
+		}
+		out.RawByte(']')
+	}
+	out.RawByte(']')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (m matrix) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	m.MarshalEasyJSON(&w)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// DurationMatrix holds travel times in seconds, sources as rows and destinations as columns.
+type DurationMatrix struct {
+	matrix
+}
+
+// NewDurationMatrix builds DurationMatrix from seconds. nil cells are treated as unreachable.
+func NewDurationMatrix(seconds [][]*float64) DurationMatrix {
+	return DurationMatrix{matrix: newMatrix(seconds)}
+}
+
+// Duration returns travel time from i-th source to j-th destination.
+func (m DurationMatrix) Duration(i, j int) (time.Duration, bool) {
+	v, ok := m.At(i, j)
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(v * float64(time.Second)), true
+}
+
+// Buckets classifies destinations of i-th source by travel time.
+// bounds are ascending bucket upper bounds, e.g. 10m and 20m make <10m, 10m-20m and >=20m buckets,
+// so len(buckets) is len(bounds)+1 and every bucket lists destination indexes.
+// Unreachable destinations are returned separately.
+func (m DurationMatrix) Buckets(i int, bounds ...time.Duration) (buckets [][]int, unreachable []int) {
+	buckets = make([][]int, len(bounds)+1)
+	for j, c := range m.Row(i) {
+		if !c.Reachable {
+			unreachable = append(unreachable, j)
+			continue
+		}
+
+		d := time.Duration(c.Value * float64(time.Second))
+		k := sort.Search(len(bounds), func(k int) bool {
+			return d < bounds[k]
+		})
+		buckets[k] = append(buckets[k], j)
+	}
+
+	return buckets, unreachable
+}
+
+// DistanceMatrix holds travel distances in meters, sources as rows and destinations as columns.
+type DistanceMatrix struct {
+	matrix
+}
+
+// NewDistanceMatrix builds DistanceMatrix from meters. nil cells are treated as unreachable.
+func NewDistanceMatrix(meters [][]*float64) DistanceMatrix {
+	return DistanceMatrix{matrix: newMatrix(meters)}
+}
+
+// set marks i-th source to j-th destination pair as reachable with value.
+func (m matrix) set(i, j int, value float64) {
+	if i < 0 || i >= len(m.cells) || j < 0 || j >= len(m.cells[i]) {
+		return
+	}
+	m.cells[i][j] = MatrixCell{Value: value, Reachable: true}
+}
+
+func newMatrix(values [][]*float64) matrix {
+	cells := make([][]MatrixCell, len(values))
+	for i, row := range values {
+		cells[i] = make([]MatrixCell, len(row))
+		for j, v := range row {
+			if v != nil {
+				cells[i][j] = MatrixCell{Value: *v, Reachable: true}
+			}
+		}
+	}
+	return matrix{cells: cells}
+}
+
+// MatrixWaypoint is an input coordinate snapped to the road network by matrix API.
+type MatrixWaypoint struct {
+	// Name of the street the coordinate snapped to.
+	Name string `json:"name"`
+	// Snapped location as lon,lat pair.
+	Location []float64 `json:"location"`
+	// Distance in meters between the input coordinate and the snapped location.
+	Distance float64 `json:"distance"`
+}
+
+// GeoPoint returns snapped location.
+func (w MatrixWaypoint) GeoPoint() GeoPoint {
+	if len(w.Location) < 2 {
+		return GeoPoint{}
+	}
+	return GeoPoint{Lon: w.Location[0], Lat: w.Location[1]}
+}
+
+// MatrixResponse is a decoded matrix API response.
+// easyjson:json
+type MatrixResponse struct {
+	Code         string           `json:"code"`
+	Durations    DurationMatrix   `json:"durations"`
+	Distances    DistanceMatrix   `json:"distances"`
+	Sources      []MatrixWaypoint `json:"sources"`
+	Destinations []MatrixWaypoint `json:"destinations"`
+}
+
+// UnreachablePair describes a source and destination mapbox could not find a route between.
+type UnreachablePair struct {
+	Source      int
+	Destination int
+
+	SourceWaypoint      MatrixWaypoint
+	DestinationWaypoint MatrixWaypoint
+}
+
+// Unreachable returns all pairs with null cells.
+// Durations are checked if requested, distances otherwise.
+func (r *MatrixResponse) Unreachable() []UnreachablePair {
+	m := r.Durations.matrix
+	if m.Rows() == 0 {
+		m = r.Distances.matrix
+	}
+
+	var pairs []UnreachablePair
+	for i, row := range m.cells {
+		for j, c := range row {
+			if c.Reachable {
+				continue
+			}
+			p := UnreachablePair{Source: i, Destination: j}
+			if i < len(r.Sources) {
+				p.SourceWaypoint = r.Sources[i]
+			}
+			if j < len(r.Destinations) {
+				p.DestinationWaypoint = r.Destinations[j]
+			}
+			pairs = append(pairs, p)
+		}
+	}
+
+	return pairs
+}
+
+// PairRouter finds a single point-to-point route, e.g. with directions API.
+// found is false when there is definitely no route between the points.
+type PairRouter interface {
+	RoutePair(ctx context.Context, from, to GeoPoint, profile DirectionsProfile,
+		opts ...CallOption) (duration, distance float64, found bool, err error)
+}
+
+// ResolveUnreachable retries every unreachable pair point-to-point with router and profile,
+// which should be the profile of the matrix request, and fills the found routes into Durations and Distances.
+// It returns pairs which are still unreachable, including ones without snapped locations of both waypoints,
+// e.g. if response has no sources or destinations, as they could not be routed.
+func (r *MatrixResponse) ResolveUnreachable(ctx context.Context, router PairRouter, profile DirectionsProfile) ([]UnreachablePair, error) {
+	var left []UnreachablePair
+	for _, p := range r.Unreachable() {
+		if len(p.SourceWaypoint.Location) < 2 || len(p.DestinationWaypoint.Location) < 2 {
+			left = append(left, p)
+			continue
+		}
+
+		duration, distance, found, err := router.RoutePair(ctx, p.SourceWaypoint.GeoPoint(), p.DestinationWaypoint.GeoPoint(), profile)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			left = append(left, p)
+			continue
+		}
+
+		r.Durations.set(p.Source, p.Destination, duration)
+		r.Distances.set(p.Source, p.Destination, distance)
+	}
+
+	return left, nil
+}
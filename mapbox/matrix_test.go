@@ -0,0 +1,136 @@
+package mapbox
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDurationMatrix_UnmarshalJSON(t *testing.T) {
+	m := DurationMatrix{}
+	if err := m.UnmarshalJSON([]byte(`[[0,573.5,null],[612,0,1200]]`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Rows() != 2 || m.Cols() != 3 {
+		t.Fatalf("unexpected matrix size %dx%d", m.Rows(), m.Cols())
+	}
+
+	tests := []struct {
+		name  string
+		i, j  int
+		value float64
+		ok    bool
+	}{
+		{name: "reachable", i: 0, j: 1, value: 573.5, ok: true},
+		{name: "unreachable", i: 0, j: 2},
+		{name: "zero diagonal", i: 1, j: 1, ok: true},
+		{name: "out of bounds row", i: 2, j: 0},
+		{name: "out of bounds col", i: 0, j: 3},
+		{name: "negative index", i: -1, j: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := m.At(tt.i, tt.j)
+			if value != tt.value || ok != tt.ok {
+				t.Errorf("At(%d, %d) = %v, %v, want %v, %v", tt.i, tt.j, value, ok, tt.value, tt.ok)
+			}
+		})
+	}
+
+	if d, ok := m.Duration(1, 2); !ok || d != 20*time.Minute {
+		t.Errorf("Duration(1, 2) = %v, %v", d, ok)
+	}
+
+	wantCol := []MatrixCell{{}, {Value: 1200, Reachable: true}}
+	if col := m.Column(2); !reflect.DeepEqual(col, wantCol) {
+		t.Errorf("Column(2) = %v, want %v", col, wantCol)
+	}
+
+	wantRow := []MatrixCell{{Reachable: true}, {Value: 573.5, Reachable: true}, {}}
+	if row := m.Row(0); !reflect.DeepEqual(row, wantRow) {
+		t.Errorf("Row(0) = %v, want %v", row, wantRow)
+	}
+
+	b, err := m.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `[[0,573.5,null],[612,0,1200]]` {
+		t.Errorf("MarshalJSON() = %s", b)
+	}
+}
+
+func TestDurationMatrix_Buckets(t *testing.T) {
+	m := DurationMatrix{}
+	if err := m.UnmarshalJSON([]byte(`[[300,600,null,1199,1500,7200]]`)); err != nil {
+		t.Fatal(err)
+	}
+
+	buckets, unreachable := m.Buckets(0, 10*time.Minute, 20*time.Minute)
+
+	wantBuckets := [][]int{{0}, {1, 3}, {4, 5}}
+	if !reflect.DeepEqual(buckets, wantBuckets) {
+		t.Errorf("Buckets() buckets = %v, want %v", buckets, wantBuckets)
+	}
+	if !reflect.DeepEqual(unreachable, []int{2}) {
+		t.Errorf("Buckets() unreachable = %v", unreachable)
+	}
+}
+
+type pairRouterFunc func(ctx context.Context, from, to GeoPoint, profile DirectionsProfile) (float64, float64, bool, error)
+
+func (f pairRouterFunc) RoutePair(ctx context.Context, from, to GeoPoint, profile DirectionsProfile,
+	_ ...CallOption) (float64, float64, bool, error) {
+	return f(ctx, from, to, profile)
+}
+
+func TestMatrixResponse_ResolveUnreachable(t *testing.T) {
+	resp := MatrixResponse{}
+	err := resp.UnmarshalJSON([]byte(`{"code":"Ok","durations":[[0,null],[null,0]],"distances":[[0,null],[null,0]],
+		"sources":[{"name":"a","location":[1,2],"distance":3},{"name":"b","location":[3,4],"distance":1}],
+		"destinations":[{"name":"a","location":[1,2],"distance":3},{"name":"b","location":[3,4],"distance":1}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pairs := resp.Unreachable()
+	if len(pairs) != 2 {
+		t.Fatalf("unexpected unreachable pairs %v", pairs)
+	}
+	if pairs[0].Source != 0 || pairs[0].Destination != 1 || pairs[0].DestinationWaypoint.GeoPoint() != (GeoPoint{Lon: 3, Lat: 4}) {
+		t.Errorf("unexpected first pair %+v", pairs[0])
+	}
+
+	left, err := resp.ResolveUnreachable(context.Background(), pairRouterFunc(
+		func(_ context.Context, from, _ GeoPoint, profile DirectionsProfile) (float64, float64, bool, error) {
+			if profile != ProfileWalking {
+				t.Errorf("unexpected profile %s", profile)
+			}
+			return 60, 1000, from.Lon == 1, nil
+		}), ProfileWalking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) != 1 || left[0].Source != 1 {
+		t.Errorf("unexpected left pairs %v", left)
+	}
+	if v, ok := resp.Distances.At(0, 1); !ok || v != 1000 {
+		t.Errorf("distance is not resolved: %v %v", v, ok)
+	}
+
+	resp = MatrixResponse{}
+	if err := resp.UnmarshalJSON([]byte(`{"code":"Ok","durations":[[0,null],[null,0]],
+		"sources":[{"name":"a","location":[1,2]},{"name":"b"}]}`)); err != nil {
+		t.Fatal(err)
+	}
+	left, err = resp.ResolveUnreachable(context.Background(), pairRouterFunc(
+		func(_ context.Context, from, to GeoPoint, _ DirectionsProfile) (float64, float64, bool, error) {
+			t.Errorf("pair %v -> %v without locations must not be routed", from, to)
+			return 60, 1000, true, nil
+		}), ProfileDriving)
+	if err != nil || len(left) != 2 {
+		t.Errorf("pairs without locations must be left unresolved, left %v, err %v", left, err)
+	}
+}
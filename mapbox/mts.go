@@ -0,0 +1,410 @@
+package mapbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+const maxTilesetSourceIDLen = 32
+
+// Tileset job stages, see TilesetJob.
+const (
+	TilesetJobQueued     = "queued"
+	TilesetJobProcessing = "processing"
+	TilesetJobSuccess    = "success"
+	TilesetJobFailed     = "failed"
+)
+
+// TilesetSourceRequest describes tileset source upload.
+type TilesetSourceRequest struct {
+	// Username of the source owner.
+	Username string
+	// ID of the source, up to 32 letters, digits, - and _.
+	ID string
+	// Features is line-delimited GeoJSON, a feature per line.
+	Features []byte
+	// Replace replaces source files, they are appended to the source otherwise.
+	Replace bool
+}
+
+// TilesetSource describes uploaded tileset source.
+// easyjson:json
+type TilesetSource struct {
+	// ID is mapbox://tileset-source/{username}/{id} reference to use in recipes.
+	ID         string `json:"id"`
+	Files      int    `json:"files"`
+	SourceSize int64  `json:"source_size"`
+	FileSize   int64  `json:"file_size"`
+}
+
+// TilesetSourceResponse wraps uploaded tileset source.
+type TilesetSourceResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Source TilesetSource
+}
+
+// RecipeValidation is a tileset recipe validation result.
+// easyjson:json
+type RecipeValidation struct {
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+// RecipeValidationResponse wraps recipe validation result.
+type RecipeValidationResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Validation RecipeValidation
+}
+
+// TilesetAttribution is an attribution shown on maps of the tileset.
+type TilesetAttribution struct {
+	Text string `json:"text"`
+	Link string `json:"link"`
+}
+
+// CreateTilesetRequest describes a tileset to create, its tiles are generated by PublishTileset.
+// easyjson:json
+type CreateTilesetRequest struct {
+	// TilesetID in username.name format.
+	TilesetID string `json:"-"`
+	// Recipe is the tileset recipe JSON, see ValidateRecipe.
+	Recipe      json.RawMessage      `json:"recipe"`
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Private     bool                 `json:"private"`
+	Attribution []TilesetAttribution `json:"attribution,omitempty"`
+}
+
+// easyjson:json
+type rawTilesetMessage struct {
+	Message string `json:"message"`
+	JobID   string `json:"jobId"`
+}
+
+// TilesetMessageResponse wraps mapbox message of a tileset change.
+type TilesetMessageResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Message string
+	// JobID is set by PublishTileset, see TilesetJob.
+	JobID string
+}
+
+// TilesetJob is a tileset publish job.
+// easyjson:json
+type TilesetJob struct {
+	ID        string `json:"id"`
+	TilesetID string `json:"tilesetId"`
+	// Stage is e.g. TilesetJobSuccess.
+	Stage string `json:"stage"`
+	// Created and Published are unix timestamps in milliseconds.
+	Created   int64 `json:"created"`
+	Published int64 `json:"published"`
+	// Errors are mapbox error messages or objects.
+	Errors   []json.RawMessage `json:"errors"`
+	Warnings []json.RawMessage `json:"warnings"`
+}
+
+// Done is true if the job succeeded or failed.
+func (j *TilesetJob) Done() bool {
+	return j.Stage == TilesetJobSuccess || j.Stage == TilesetJobFailed
+}
+
+// TilesetJobResponse wraps tileset job.
+type TilesetJobResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Job TilesetJob
+}
+
+// UploadTilesetSource calls tilesets/v1 sources mapbox API thought fasthttp client,
+// features are sent as a multipart form file.
+func (c *FastHttpTilesets) UploadTilesetSource(ctx context.Context, req *TilesetSourceRequest, opts ...CallOption) (*TilesetSourceResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if req.Username == "" {
+		return nil, validationErrorf("Username", ConstraintRequired, "username is required")
+	}
+	if err := validateTilesetSourceID(req.ID); err != nil {
+		return nil, err
+	}
+	if len(req.Features) == 0 {
+		return nil, validationErrorf("Features", ConstraintRequired, "features are required")
+	}
+
+	body := bytes.Buffer{}
+	form := multipart.NewWriter(&body)
+	file, err := form.CreateFormFile("file", req.ID+".geojson.ld")
+	if err != nil {
+		return nil, errorf("failed to create tileset source form: %w", err)
+	}
+	if _, err := file.Write(req.Features); err != nil {
+		return nil, errorf("failed to write tileset source form: %w", err)
+	}
+	if err := form.Close(); err != nil {
+		return nil, errorf("failed to close tileset source form: %w", err)
+	}
+
+	method := postMethod
+	if req.Replace {
+		method = putMethod
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.tilesetsAPIURL.Write(buf, nil, "sources/", req.Username, slash, req.ID)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "upload tileset source", reqURI, nil, logKeyUsername, req.Username, logKeySource, req.ID)
+
+	resp, err := c.doContent(ctx, "upload tileset source", method, reqURI, body.Bytes(), form.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "upload tileset source", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("upload tileset source", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	source := TilesetSource{}
+	if err := c.decode(resp, &source); err != nil {
+		return nil, errorf("failed to unmarshall tileset source resp %s: %w", string(resp.body), err)
+	}
+
+	return &TilesetSourceResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Source:    source,
+	}, nil
+}
+
+// ValidateRecipe calls tilesets/v1 validateRecipe mapbox API thought fasthttp client.
+// An invalid recipe is not an error, see RecipeValidation.Errors.
+func (c *FastHttpTilesets) ValidateRecipe(ctx context.Context, recipe json.RawMessage, opts ...CallOption) (*RecipeValidationResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if len(recipe) == 0 {
+		return nil, validationErrorf("recipe", ConstraintRequired, "recipe is required")
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.tilesetsAPIURL.Write(buf, nil, "validateRecipe")
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "validate recipe", reqURI, nil)
+
+	resp, err := c.do(ctx, "validate recipe", putMethod, reqURI, recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "validate recipe", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("validate recipe", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	validation := RecipeValidation{}
+	if err := c.decode(resp, &validation); err != nil {
+		return nil, errorf("failed to unmarshall recipe validation resp %s: %w", string(resp.body), err)
+	}
+
+	return &RecipeValidationResponse{
+		RateLimit:  resp.rateLimit,
+		Meta:       resp.meta,
+		RawResp:    resp.body,
+		Validation: validation,
+	}, nil
+}
+
+// CreateTileset calls tilesets/v1 create mapbox API thought fasthttp client.
+func (c *FastHttpTilesets) CreateTileset(ctx context.Context, req *CreateTilesetRequest, opts ...CallOption) (*TilesetMessageResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := validateTilesetID(req.TilesetID); err != nil {
+		return nil, err
+	}
+	if len(req.Recipe) == 0 {
+		return nil, validationErrorf("Recipe", ConstraintRequired, "recipe is required")
+	}
+	if req.Name == "" {
+		return nil, validationErrorf("Name", ConstraintRequired, "name is required")
+	}
+
+	body, err := req.MarshalJSON()
+	if err != nil {
+		return nil, errorf("failed to marshal tileset: %w", err)
+	}
+
+	return c.tilesetMessage(ctx, "create tileset", req.TilesetID, "", body)
+}
+
+// PublishTileset calls tilesets/v1 publish mapbox API thought fasthttp client,
+// it starts a job to generate tiles, see TilesetJob and WaitForTilesetJob.
+func (c *FastHttpTilesets) PublishTileset(ctx context.Context, tilesetID string, opts ...CallOption) (*TilesetMessageResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := validateTilesetID(tilesetID); err != nil {
+		return nil, err
+	}
+
+	return c.tilesetMessage(ctx, "publish tileset", tilesetID, "/publish", nil)
+}
+
+func (c *FastHttpTilesets) tilesetMessage(ctx context.Context, op, tilesetID, path string, body []byte) (*TilesetMessageResponse, error) {
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.tilesetsAPIURL.Write(buf, nil, tilesetID, path)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, op, reqURI, nil, logKeyTileset, tilesetID)
+
+	resp, err := c.do(ctx, op, postMethod, reqURI, body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, op, resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError(op, string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawTilesetMessage{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall %s resp %s: %w", op, string(resp.body), err)
+	}
+
+	return &TilesetMessageResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Message:   respRaw.Message,
+		JobID:     respRaw.JobID,
+	}, nil
+}
+
+// TilesetJob calls tilesets/v1 job status mapbox API thought fasthttp client.
+func (c *FastHttpTilesets) TilesetJob(ctx context.Context, tilesetID, jobID string, opts ...CallOption) (*TilesetJobResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := validateTilesetID(tilesetID); err != nil {
+		return nil, err
+	}
+	if jobID == "" {
+		return nil, validationErrorf("jobID", ConstraintRequired, "job id is required")
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.tilesetsAPIURL.Write(buf, nil, tilesetID, "/jobs/", jobID)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "get tileset job", reqURI, nil, logKeyTileset, tilesetID, logKeyJobID, jobID)
+
+	resp, err := c.do(ctx, "get tileset job", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "get tileset job", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("get tileset job", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	job := TilesetJob{}
+	if err := c.decode(resp, &job); err != nil {
+		return nil, errorf("failed to unmarshall tileset job resp %s: %w", string(resp.body), err)
+	}
+
+	return &TilesetJobResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Job:       job,
+	}, nil
+}
+
+// WaitForTilesetJob polls TilesetJob every PollInterval until the job succeeds or fails.
+// A failed job is returned without an error, check its Stage and Errors.
+func (c *FastHttpTilesets) WaitForTilesetJob(ctx context.Context, tilesetID, jobID string, opts ...CallOption) (*TilesetJob, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	ctx, cancel := c.life.bind(ctx)
+	defer cancel()
+
+	for {
+		resp, err := c.TilesetJob(ctx, tilesetID, jobID)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Job.Done() {
+			return &resp.Job, nil
+		}
+
+		if err := c.clock.Sleep(ctx, c.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func validateTilesetID(id string) error {
+	if id == "" {
+		return validationErrorf("TilesetID", ConstraintRequired, "tileset id is required")
+	}
+	if i := strings.IndexByte(id, '.'); i <= 0 || i == len(id)-1 {
+		return validationErrorf("TilesetID", ConstraintFormat, "invalid tileset id %q, username.name expected", id)
+	}
+	return nil
+}
+
+func validateTilesetSourceID(id string) error {
+	if id == "" {
+		return validationErrorf("ID", ConstraintRequired, "source id is required")
+	}
+	if len(id) > maxTilesetSourceIDLen {
+		return validationErrorf("ID", ConstraintMaxLength, "source id %q is longer than %d", id, maxTilesetSourceIDLen)
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return validationErrorf("ID", ConstraintFormat, "invalid source id %q, letters, digits, - and _ expected", id)
+		}
+	}
+	return nil
+}
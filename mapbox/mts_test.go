@@ -0,0 +1,130 @@
+package mapbox
+
+import (
+	"context"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpTilesets_UploadTilesetSource(t *testing.T) {
+	features := []byte(`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{}}` + "\n")
+	c := NewFastHttpTilesets(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			if uri := string(req.RequestURI()); uri != "https://api.mapbox.com/tilesets/v1/sources/user/parcels?access_token=token" {
+				t.Errorf("unexpected uri %s", uri)
+			}
+			if method := string(req.Header.Method()); method != "PUT" {
+				t.Errorf("method = %s, want PUT", method)
+			}
+
+			mediaType, params, err := mime.ParseMediaType(string(req.Header.ContentType()))
+			if err != nil || mediaType != "multipart/form-data" {
+				t.Fatalf("unexpected content type %s", req.Header.ContentType())
+			}
+			part, err := multipart.NewReader(strings.NewReader(string(req.Body())), params["boundary"]).NextPart()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := ioutil.ReadAll(part); part.FormName() != "file" || string(got) != string(features) {
+				t.Errorf("unexpected form file %s %s", part.FormName(), got)
+			}
+
+			resp.SetBodyString(`{"id":"mapbox://tileset-source/user/parcels","files":1,"source_size":90,"file_size":90}`)
+			return nil
+		})))
+
+	resp, err := c.UploadTilesetSource(context.Background(), &TilesetSourceRequest{Username: "user", ID: "parcels", Features: features, Replace: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Source.ID != "mapbox://tileset-source/user/parcels" || resp.Source.Files != 1 {
+		t.Errorf("unexpected source %+v", resp.Source)
+	}
+
+	invalid := []*TilesetSourceRequest{
+		{ID: "parcels", Features: features},
+		{Username: "user", ID: "parcels/2020", Features: features},
+		{Username: "user", ID: strings.Repeat("a", 33), Features: features},
+		{Username: "user", ID: "parcels"},
+	}
+	for _, req := range invalid {
+		if _, err := c.UploadTilesetSource(context.Background(), req); err == nil {
+			t.Errorf("UploadTilesetSource(%s/%s) error expected", req.Username, req.ID)
+		}
+	}
+}
+
+func TestFastHttpTilesets_Publish(t *testing.T) {
+	recipe := []byte(`{"version":1,"layers":{"parcels":{"source":"mapbox://tileset-source/user/parcels","minzoom":0,"maxzoom":5}}}`)
+	stages := []string{TilesetJobQueued, TilesetJobProcessing, TilesetJobSuccess}
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	c := NewFastHttpTilesets(AccessToken("token"), WithClock(clock), PollInterval(10*time.Second), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			switch {
+			case strings.Contains(uri, "/validateRecipe"):
+				if string(req.Body()) != string(recipe) || string(req.Header.Method()) != "PUT" {
+					t.Errorf("unexpected validate recipe request %s", req.Body())
+				}
+				resp.SetBodyString(`{"valid":true,"errors":[],"warnings":["maxzoom 5 is low"]}`)
+			case strings.HasSuffix(uri, "/user.parcels?access_token=token"):
+				want := `{"recipe":` + string(recipe) + `,"name":"Parcels","private":true}`
+				if string(req.Body()) != want {
+					t.Errorf("create body = %s, want %s", req.Body(), want)
+				}
+				resp.SetBodyString(`{"message":"Successfully created empty tileset user.parcels."}`)
+			case strings.HasSuffix(uri, "/user.parcels/publish?access_token=token"):
+				resp.SetBodyString(`{"message":"Processing user.parcels","jobId":"job1"}`)
+			case strings.HasSuffix(uri, "/user.parcels/jobs/job1?access_token=token"):
+				resp.SetBodyString(`{"id":"job1","tilesetId":"user.parcels","stage":"` + stages[0] + `","created":1560981902377}`)
+				stages = stages[1:]
+			default:
+				t.Errorf("unexpected uri %s", uri)
+			}
+			return nil
+		})))
+	ctx := context.Background()
+
+	validation, err := c.ValidateRecipe(ctx, recipe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !validation.Validation.Valid || len(validation.Validation.Warnings) != 1 {
+		t.Errorf("unexpected validation %+v", validation.Validation)
+	}
+
+	if _, err := c.CreateTileset(ctx, &CreateTilesetRequest{TilesetID: "user.parcels", Recipe: recipe, Name: "Parcels", Private: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	published, err := c.PublishTileset(ctx, "user.parcels")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if published.JobID != "job1" {
+		t.Errorf("unexpected publish response %+v", published)
+	}
+
+	job, err := c.WaitForTilesetJob(ctx, "user.parcels", published.JobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Stage != TilesetJobSuccess || job.TilesetID != "user.parcels" || len(clock.sleeps) != 2 || clock.sleeps[0] != 10*time.Second {
+		t.Errorf("unexpected job %+v after sleeps %v", job, clock.sleeps)
+	}
+
+	for _, id := range []string{"", "parcels", "user.", ".parcels"} {
+		if _, err := c.PublishTileset(ctx, id); err == nil {
+			t.Errorf("PublishTileset(%q) error expected", id)
+		}
+	}
+	if _, err := c.CreateTileset(ctx, &CreateTilesetRequest{TilesetID: "user.parcels", Name: "Parcels"}); err == nil {
+		t.Error("CreateTileset() without recipe error expected")
+	}
+}
@@ -0,0 +1,89 @@
+//go:build mapboxnethttp
+// +build mapboxnethttp
+
+// Package nethttp sends SDK requests with net/http and decodes geocode responses with encoding/json,
+// for consumers who can't use fasthttp transport or easyjson generated decoders.
+// It is opt-in, build with -tags mapboxnethttp.
+package nethttp
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/humans-net/mapbox-sdk-go/mapbox"
+)
+
+// contentLengthHeader is skipped as the body could be decompressed by net/http.
+const contentLengthHeader = "Content-Length"
+
+var hostHeader = []byte("Host")
+
+// Client is a mapbox.FastHttpClient sending requests with net/http client.
+type Client struct {
+	// HTTP client, http.DefaultClient is used if nil.
+	HTTP *http.Client
+}
+
+// Options returns SDK options to send requests with c, nil uses http.DefaultClient,
+// and to decode forward and reverse geocode responses with DecodeGeocode.
+func Options(c *http.Client) []mapbox.Option {
+	return []mapbox.Option{
+		mapbox.HttpClient(&Client{HTTP: c}),
+		mapbox.CustomDecoder(mapbox.EndpointForwardGeocode, decodeGeocode),
+		mapbox.CustomDecoder(mapbox.EndpointReverseGeocode, decodeGeocode),
+	}
+}
+
+// Do sends req with net/http client and copies the response into resp.
+// SDK requests have absolute request URIs, they are sent as is.
+func (c *Client) Do(req *fasthttp.Request, resp *fasthttp.Response) error {
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var body io.Reader
+	if b := req.Body(); len(b) > 0 {
+		body = bytes.NewReader(b)
+	}
+
+	httpReq, err := http.NewRequest(string(req.Header.Method()), string(req.RequestURI()), body)
+	if err != nil {
+		return err
+	}
+	req.Header.VisitAll(func(k, v []byte) {
+		if !bytes.EqualFold(k, hostHeader) {
+			httpReq.Header.Add(string(k), string(v))
+		}
+	})
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	b, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+
+	resp.SetStatusCode(httpResp.StatusCode)
+	for k, vs := range httpResp.Header {
+		if k == contentLengthHeader {
+			continue
+		}
+		// Set parses special headers like Content-Type, Add only appends them.
+		resp.Header.Set(k, vs[0])
+		for _, v := range vs[1:] {
+			resp.Header.Add(k, v)
+		}
+	}
+	resp.SetBody(b)
+
+	return nil
+}
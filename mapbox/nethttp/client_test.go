@@ -0,0 +1,72 @@
+//go:build mapboxnethttp
+// +build mapboxnethttp
+
+package nethttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/humans-net/mapbox-sdk-go/mapbox"
+)
+
+const testReverseRespBody = `{"type":"FeatureCollection","query":[-77.05,38.889],"features":[
+{"id":"address.1","type":"Feature","place_type":["address"],"relevance":1,"properties":{"accuracy":"rooftop"},
+"text":"Constitution Avenue","place_name":"2 Constitution Avenue, Washington, DC","center":[-77.05,38.889],
+"geometry":{"type":"Point","coordinates":[-77.05,38.889]},"address":"2",
+"context":[{"id":"country.1","text":"United States","wikidata":"Q30","short_code":"us"}]}]}`
+
+func TestClient_ReverseGeocode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/geocoding/v5/mapbox.places/-77.050000,38.889000.json" || r.URL.Query().Get("access_token") != "token" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testReverseRespBody))
+	}))
+	defer srv.Close()
+
+	g := mapbox.NewFastHttpGeocoder(append(Options(srv.Client()), mapbox.AccessToken("token"), mapbox.RootAPI(srv.URL))...)
+
+	resp, err := g.ReverseGeocode(context.Background(), &mapbox.ReverseGeocodeRequest{
+		GeoPoint: mapbox.GeoPoint{Lon: -77.05, Lat: 38.889},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Meta.RequestID != "req-1" {
+		t.Errorf("unexpected meta %+v", resp.Meta)
+	}
+
+	decoded, ok := resp.Decoded.(*mapbox.GeocodeResponse)
+	if !ok || len(decoded.Features) != 1 {
+		t.Fatalf("unexpected decoded %+v", resp.Decoded)
+	}
+	f := decoded.Features[0]
+	switch {
+	case f.Properties.Accuracy != mapbox.AccuracyRooftop || f.Address != "2" || f.Geometry.Coordinates[1] != 38.889:
+		t.Errorf("unexpected feature %+v", f)
+	case len(f.Context) != 1 || f.Context[0].ShortCode != "us":
+		t.Errorf("unexpected context %+v", f.Context)
+	}
+}
+
+func TestClient_Status(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"Not Authorized - Invalid Token"}`))
+	}))
+	defer srv.Close()
+
+	g := mapbox.NewFastHttpGeocoder(append(Options(nil), mapbox.AccessToken("token"), mapbox.RootAPI(srv.URL))...)
+
+	_, err := g.ReverseGeocode(context.Background(), &mapbox.ReverseGeocodeRequest{
+		GeoPoint: mapbox.GeoPoint{Lon: 1, Lat: 2},
+	})
+	if err == nil {
+		t.Error("status error expected")
+	}
+}
@@ -0,0 +1,97 @@
+//go:build mapboxnethttp
+// +build mapboxnethttp
+
+package nethttp
+
+import (
+	"encoding/json"
+
+	"github.com/humans-net/mapbox-sdk-go/mapbox"
+)
+
+// geocodeResp mirrors mapbox geocode response without easyjson generated decoders of SDK types.
+type geocodeResp struct {
+	Type     string    `json:"type"`
+	Features []feature `json:"features"`
+}
+
+type feature struct {
+	ID          string           `json:"id"`
+	Type        string           `json:"type"`
+	PlaceType   []string         `json:"place_type"`
+	Relevance   float64          `json:"relevance"`
+	Properties  properties       `json:"properties"`
+	Text        string           `json:"text"`
+	PlaceName   string           `json:"place_name"`
+	Center      []float64        `json:"center"`
+	Geometry    geometry         `json:"geometry"`
+	Address     string           `json:"address"`
+	Context     []featureContext `json:"context"`
+	BoundingBox []float64        `json:"bbox"`
+}
+
+type properties struct {
+	Accuracy  string `json:"accuracy"`
+	ShortCode string `json:"short_code"`
+	Wikidata  string `json:"wikidata"`
+	Category  string `json:"category"`
+	Landmark  bool   `json:"landmark"`
+	Maki      string `json:"maki"`
+}
+
+type geometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+type featureContext struct {
+	ID        string `json:"id"`
+	Text      string `json:"text"`
+	Wikidata  string `json:"wikidata"`
+	ShortCode string `json:"short_code"`
+}
+
+// DecodeGeocode decodes forward or reverse geocode response body with encoding/json.
+// Only Type and Features of the response are set.
+func DecodeGeocode(body []byte) (*mapbox.GeocodeResponse, error) {
+	var raw geocodeResp
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	features := make([]mapbox.Feature, len(raw.Features))
+	for i, f := range raw.Features {
+		contexts := make([]mapbox.Context, len(f.Context))
+		for j, c := range f.Context {
+			contexts[j] = mapbox.Context(c)
+		}
+
+		features[i] = mapbox.Feature{
+			ID:        f.ID,
+			Type:      f.Type,
+			PlaceType: f.PlaceType,
+			Relevance: f.Relevance,
+			Properties: mapbox.Properties{
+				Accuracy:  mapbox.Accuracy(f.Properties.Accuracy),
+				ShortCode: f.Properties.ShortCode,
+				Wikidata:  f.Properties.Wikidata,
+				Category:  f.Properties.Category,
+				Landmark:  f.Properties.Landmark,
+				Maki:      f.Properties.Maki,
+			},
+			Text:        f.Text,
+			PlaceName:   f.PlaceName,
+			Center:      f.Center,
+			Geometry:    mapbox.Geometry(f.Geometry),
+			Address:     f.Address,
+			Context:     contexts,
+			BoundingBox: f.BoundingBox,
+		}
+	}
+
+	return &mapbox.GeocodeResponse{Type: raw.Type, Features: features}, nil
+}
+
+func decodeGeocode(body []byte) (interface{}, error) {
+	return DecodeGeocode(body)
+}
@@ -0,0 +1,265 @@
+package mapbox
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	destination   = "destination"
+	distributions = "distributions"
+	roundtrip     = "roundtrip"
+	source        = "source"
+)
+
+// Optimization trip ends, see OptimizationRequest.Source and Destination.
+const (
+	TripSourceAny       = "any"
+	TripSourceFirst     = "first"
+	TripDestinationAny  = "any"
+	TripDestinationLast = "last"
+)
+
+// Optimization response codes.
+const (
+	OptimizationCodeOk      = "Ok"
+	OptimizationCodeNoTrips = "NoTrips"
+)
+
+// Distribution is a pickup and dropoff pair of coordinate indexes, the pickup is visited first.
+type Distribution struct {
+	Pickup  int
+	Dropoff int
+}
+
+// OptimizationRequest describes optimized-trips/v1 request.
+type OptimizationRequest struct {
+	// Profile default to ProfileDriving.
+	Profile DirectionsProfile
+	// Coordinates of 2 to MaxOptimizationCoordinates waypoints.
+	Coordinates []GeoPoint
+	// Roundtrip returns to the first coordinate, mapbox default is true.
+	// Trips which are not roundtrips require TripSourceFirst and TripDestinationLast.
+	Roundtrip *bool
+	// Source is the trip start, TripSourceAny or TripSourceFirst, mapbox default is any.
+	Source string
+	// Destination is the trip end, TripDestinationAny or TripDestinationLast, mapbox default is any.
+	Destination string
+	// Distributions are pickup and dropoff pairs, e.g. of courier orders.
+	Distributions []Distribution
+	// Geometries format, mapbox default is GeometriesPolyline.
+	Geometries string
+	// Overview geometry detail level, mapbox default is OverviewSimplified.
+	Overview string
+	// Steps requests turn-by-turn instructions.
+	Steps bool
+	// Language of instructions, default to language set with WithContextLanguage.
+	Language string
+	// Annotations requests per segment metadata, e.g. AnnotationDuration.
+	Annotations []string
+}
+
+// OptimizedWaypoint is an input coordinate snapped to the road network with its place in the trip.
+type OptimizedWaypoint struct {
+	// Name of the street the coordinate snapped to.
+	Name string `json:"name"`
+	// Snapped location as lon,lat pair.
+	Location []float64 `json:"location"`
+	// WaypointIndex is the position in the trip.
+	WaypointIndex int `json:"waypoint_index"`
+	// TripsIndex is the index of the trip visiting the waypoint.
+	TripsIndex int `json:"trips_index"`
+}
+
+// GeoPoint returns snapped location.
+func (w OptimizedWaypoint) GeoPoint() GeoPoint {
+	if len(w.Location) < 2 {
+		return GeoPoint{}
+	}
+	return GeoPoint{Lon: w.Location[0], Lat: w.Location[1]}
+}
+
+// easyjson:json
+type rawOptimizationResp struct {
+	Code      string              `json:"code"`
+	Message   string              `json:"message,omitempty"`
+	Trips     []Route             `json:"trips"`
+	Waypoints []OptimizedWaypoint `json:"waypoints"`
+}
+
+// OptimizationResponse wraps optimized trips.
+type OptimizationResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	// Code is OptimizationCodeOk or OptimizationCodeNoTrips, Trips are empty for the latter.
+	Code  string
+	Trips []Route
+	// Waypoints are in input coordinates order.
+	Waypoints []OptimizedWaypoint
+}
+
+// Order returns input coordinate indexes in visiting order.
+func (r *OptimizationResponse) Order() []int {
+	order := make([]int, len(r.Waypoints))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return r.Waypoints[order[i]].WaypointIndex < r.Waypoints[order[j]].WaypointIndex
+	})
+	return order
+}
+
+// Optimization covers mapbox optimization API.
+type Optimization interface {
+	// OptimizeTrip calls optimized-trips/v1 mapbox API
+	OptimizeTrip(ctx context.Context, req *OptimizationRequest, opts ...CallOption) (*OptimizationResponse, error)
+	// SubmitRoutingProblem calls optimized-trips/v2 mapbox API to start solving problem asynchronously
+	SubmitRoutingProblem(ctx context.Context, problem *RoutingProblem, opts ...CallOption) (*OptimizationJobResponse, error)
+	// RoutingSolution calls optimized-trips/v2 mapbox API to get job status and solution if it is complete
+	RoutingSolution(ctx context.Context, jobID string, opts ...CallOption) (*RoutingSolutionResponse, error)
+	// WaitForSolution polls job until it is complete.
+	WaitForSolution(ctx context.Context, jobID string, opts ...CallOption) (*RoutingSolution, error)
+}
+
+// FastHttpOptimization is a fasthttp Optimization implementation.
+type FastHttpOptimization struct {
+	config
+
+	optimizationAPIURL   EndpointURL
+	optimizationV2APIURL EndpointURL
+
+	// submissions dedupes routing problem submissions by idempotency key.
+	submissions *submissions
+
+	stringBufPull *stringsBufferPool
+}
+
+// OptimizeTrip calls optimized-trips/v1 mapbox API thought fasthttp client.
+func (c *FastHttpOptimization) OptimizeTrip(ctx context.Context, req *OptimizationRequest, opts ...CallOption) (*OptimizationResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := ValidateCoordinates(req.Coordinates, MaxOptimizationCoordinates); err != nil {
+		return nil, err
+	}
+	if req.Roundtrip != nil && !*req.Roundtrip && (req.Source != TripSourceFirst || req.Destination != TripDestinationLast) {
+		return nil, validationErrorf("Roundtrip", ConstraintDepends,
+			"trip which is not a roundtrip requires %s source and %s destination", TripSourceFirst, TripDestinationLast)
+	}
+
+	profile := req.Profile
+	if profile == "" {
+		profile = ProfileDriving
+	}
+
+	values := make(map[string]string, 10)
+	if req.Roundtrip != nil {
+		values[roundtrip] = strconv.FormatBool(*req.Roundtrip)
+	}
+	if req.Source != "" {
+		values[source] = req.Source
+	}
+	if req.Destination != "" {
+		values[destination] = req.Destination
+	}
+	if len(req.Distributions) > 0 {
+		d, err := formatDistributions(req.Distributions, len(req.Coordinates))
+		if err != nil {
+			return nil, err
+		}
+		values[distributions] = d
+	}
+	if req.Geometries != "" {
+		values[geometries] = req.Geometries
+	}
+	if req.Overview != "" {
+		values[overview] = req.Overview
+	}
+	if req.Steps {
+		values[steps] = trueStr
+	}
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
+	}
+	if len(req.Annotations) > 0 {
+		values[annotations] = strings.Join(req.Annotations, ",")
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.optimizationAPIURL.Write(buf, values, string(profile), slash, formatCoordinates(req.Coordinates))
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "optimize trip", reqURI, values, logKeyProfile, string(profile),
+		logKeyCoordinates, strconv.Itoa(len(req.Coordinates)))
+
+	resp, err := c.do(ctx, "optimize trip", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "optimize trip", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("optimize trip", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawOptimizationResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall optimization resp %s: %w", string(resp.body), err)
+	}
+	if respRaw.Code != OptimizationCodeOk && respRaw.Code != OptimizationCodeNoTrips {
+		return nil, errorf("failed to optimize trip code %s message %s", respRaw.Code, respRaw.Message)
+	}
+	setPolyline6(respRaw.Trips, req.Geometries)
+
+	return &OptimizationResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Code:      respRaw.Code,
+		Trips:     respRaw.Trips,
+		Waypoints: respRaw.Waypoints,
+	}, nil
+}
+
+// formatDistributions validates coordinate indexes and formats pairs as semicolon separated pickup,dropoff.
+func formatDistributions(ds []Distribution, n int) (string, error) {
+	buf := bytes.Buffer{}
+	for i, d := range ds {
+		if d.Pickup < 0 || d.Pickup >= n || d.Dropoff < 0 || d.Dropoff >= n || d.Pickup == d.Dropoff {
+			return "", validationErrorf(indexField("Distributions", i), ConstraintRange,
+				"invalid distribution %d: %d,%d of %d coordinates", i, d.Pickup, d.Dropoff, n)
+		}
+		if i > 0 {
+			buf.WriteByte(';')
+		}
+		buf.WriteString(strconv.Itoa(d.Pickup))
+		buf.WriteByte(comma)
+		buf.WriteString(strconv.Itoa(d.Dropoff))
+	}
+	return buf.String(), nil
+}
+
+// NewFastHttpOptimization creates fasthttp Optimization client.
+func NewFastHttpOptimization(opts ...Option) *FastHttpOptimization {
+	c := FastHttpOptimization{
+		config:        build(opts),
+		submissions:   newSubmissions(),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.optimizationAPIURL = c.endpointURL("/optimized-trips/v1/mapbox/")
+	c.optimizationV2APIURL = c.endpointURL("/optimized-trips/v2")
+
+	return &c
+}
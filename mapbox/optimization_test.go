@@ -0,0 +1,58 @@
+package mapbox
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpOptimization(t *testing.T) {
+	var uri string
+	o := NewFastHttpOptimization(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(`{"code":"Ok","waypoints":[
+{"name":"A","location":[13.38,52.51],"waypoint_index":0,"trips_index":0},
+{"name":"B","location":[13.40,52.52],"waypoint_index":2,"trips_index":0},
+{"name":"C","location":[13.39,52.50],"waypoint_index":1,"trips_index":0}],
+"trips":[{"geometry":"abc","legs":[],"weight_name":"routability","weight":1,"duration":600,"distance":4000}]}`)
+			return nil
+		})))
+
+	off := false
+	resp, err := o.OptimizeTrip(context.Background(), &OptimizationRequest{
+		Coordinates:   []GeoPoint{{Lon: 13.38, Lat: 52.51}, {Lon: 13.40, Lat: 52.52}, {Lon: 13.39, Lat: 52.50}},
+		Roundtrip:     &off,
+		Source:        TripSourceFirst,
+		Destination:   TripDestinationLast,
+		Distributions: []Distribution{{Pickup: 2, Dropoff: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "/optimized-trips/v1/mapbox/driving/13.380000,52.510000;13.400000,52.520000;13.390000,52.500000" +
+		"?access_token=token&destination=last&distributions=2,1&roundtrip=false&source=first"
+	if !strings.HasSuffix(uri, want) {
+		t.Errorf("uri = %s, want suffix %s", uri, want)
+	}
+	if len(resp.Trips) != 1 || resp.Trips[0].Duration != 600 || resp.Trips[0].Geometry.Polyline != "abc" {
+		t.Errorf("unexpected trips %+v", resp.Trips)
+	}
+	if order := resp.Order(); !reflect.DeepEqual(order, []int{0, 2, 1}) {
+		t.Errorf("Order() = %v", order)
+	}
+
+	for _, req := range []OptimizationRequest{
+		{Coordinates: []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}}, Roundtrip: &off},
+		{Coordinates: []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}}, Distributions: []Distribution{{Pickup: 0, Dropoff: 2}}},
+	} {
+		req := req
+		if _, err := o.OptimizeTrip(context.Background(), &req); err == nil {
+			t.Errorf("error expected for %+v", req)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+package mapbox
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// routingProblemVersion is the only optimization v2 problem document version.
+const routingProblemVersion = 1
+
+// Optimization v2 job statuses.
+const (
+	OptimizationJobProcessing = "processing"
+	OptimizationJobComplete   = "complete"
+)
+
+// Routing problem time window types, see TimeWindow.Type.
+const (
+	TimeWindowStrict    = "strict"
+	TimeWindowSoft      = "soft"
+	TimeWindowSoftStart = "soft_start"
+	TimeWindowSoftEnd   = "soft_end"
+)
+
+// Solution stop types.
+const (
+	StopStart   = "start"
+	StopService = "service"
+	StopPickup  = "pickup"
+	StopDropoff = "dropoff"
+	StopBreak   = "break"
+	StopEnd     = "end"
+)
+
+// RoutingProblem is an optimization v2 problem document, items refer to each other by name.
+// easyjson:json
+type RoutingProblem struct {
+	// Version default to 1.
+	Version   int               `json:"version"`
+	Locations []ProblemLocation `json:"locations"`
+	Vehicles  []ProblemVehicle  `json:"vehicles"`
+	Services  []ProblemService  `json:"services,omitempty"`
+	Shipments []ProblemShipment `json:"shipments,omitempty"`
+	Options   *ProblemOptions   `json:"options,omitempty"`
+}
+
+// ProblemLocation is a named point, Coordinates are a lon,lat pair.
+type ProblemLocation struct {
+	Name        string    `json:"name"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// ProblemVehicle is a vehicle serving the problem, capacities are keyed by arbitrary units, e.g. boxes.
+type ProblemVehicle struct {
+	Name string `json:"name"`
+	// RoutingProfile is e.g. mapbox/driving, mapbox default is mapbox/driving.
+	RoutingProfile string `json:"routing_profile,omitempty"`
+	// StartLocation and EndLocation are location names.
+	StartLocation string         `json:"start_location,omitempty"`
+	EndLocation   string         `json:"end_location,omitempty"`
+	Capacities    map[string]int `json:"capacities,omitempty"`
+	// Capabilities are matched against service and shipment requirements.
+	Capabilities  []string   `json:"capabilities,omitempty"`
+	EarliestStart *time.Time `json:"earliest_start,omitempty"`
+	LatestEnd     *time.Time `json:"latest_end,omitempty"`
+}
+
+// TimeWindow is a period a stop could be made within.
+type TimeWindow struct {
+	Earliest time.Time `json:"earliest"`
+	Latest   time.Time `json:"latest"`
+	// Type default to TimeWindowStrict.
+	Type string `json:"type,omitempty"`
+}
+
+// ProblemService is a single stop job, durations are in seconds.
+type ProblemService struct {
+	Name         string       `json:"name"`
+	Location     string       `json:"location"`
+	Duration     int          `json:"duration,omitempty"`
+	Requirements []string     `json:"requirements,omitempty"`
+	ServiceTimes []TimeWindow `json:"service_times,omitempty"`
+}
+
+// ProblemShipment is a pickup and dropoff job, durations are in seconds.
+type ProblemShipment struct {
+	Name string `json:"name"`
+	// From and To are pickup and dropoff location names.
+	From            string         `json:"from"`
+	To              string         `json:"to"`
+	Size            map[string]int `json:"size,omitempty"`
+	Requirements    []string       `json:"requirements,omitempty"`
+	PickupDuration  int            `json:"pickup_duration,omitempty"`
+	DropoffDuration int            `json:"dropoff_duration,omitempty"`
+	PickupTimes     []TimeWindow   `json:"pickup_times,omitempty"`
+	DropoffTimes    []TimeWindow   `json:"dropoff_times,omitempty"`
+}
+
+// ProblemOptions tune the solver.
+type ProblemOptions struct {
+	// Objectives are e.g. min-total-travel-duration or min-schedule-completion-time.
+	Objectives []string `json:"objectives,omitempty"`
+}
+
+// RoutingSolution is a solved routing problem.
+// easyjson:json
+type RoutingSolution struct {
+	// Dropped are names of services and shipments no vehicle could serve.
+	Dropped DroppedJobs     `json:"dropped"`
+	Routes  []SolutionRoute `json:"routes"`
+}
+
+// DroppedJobs are names of unserved services and shipments.
+type DroppedJobs struct {
+	Services  []string `json:"services"`
+	Shipments []string `json:"shipments"`
+}
+
+// SolutionRoute is a vehicle schedule.
+type SolutionRoute struct {
+	Vehicle string         `json:"vehicle"`
+	Stops   []SolutionStop `json:"stops"`
+}
+
+// SolutionStop is a vehicle stop, Odometer is in meters and Wait and Duration are in seconds.
+type SolutionStop struct {
+	// Type is e.g. StopService.
+	Type string `json:"type"`
+	// Location is a location name.
+	Location string    `json:"location"`
+	ETA      time.Time `json:"eta"`
+	Odometer float64   `json:"odometer"`
+	Wait     int       `json:"wait,omitempty"`
+	Duration int       `json:"duration,omitempty"`
+	// Services, Pickups and Dropoffs are names of jobs done at the stop.
+	Services []string `json:"services,omitempty"`
+	Pickups  []string `json:"pickups,omitempty"`
+	Dropoffs []string `json:"dropoffs,omitempty"`
+}
+
+// easyjson:json
+type rawOptimizationJob struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// OptimizationJobResponse wraps a submitted routing problem job.
+type OptimizationJobResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	ID string
+	// IdempotencyKey the job was submitted with, see SubmitRoutingProblem.
+	IdempotencyKey string
+}
+
+// RoutingSolutionResponse wraps routing problem job status.
+type RoutingSolutionResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	// Status is OptimizationJobProcessing or OptimizationJobComplete.
+	Status string
+	// Solution is set if the job is complete.
+	Solution *RoutingSolution
+}
+
+// SubmitRoutingProblem calls optimized-trips/v2 mapbox API thought fasthttp client.
+// Submission is sent with Idempotency-Key header derived from the problem unless WithIdempotencyKey is set,
+// submissions with a key already submitted by the client within 30 minutes return that job instead of
+// creating a duplicate billed one, e.g. when a submission is retried after a network error.
+func (c *FastHttpOptimization) SubmitRoutingProblem(ctx context.Context, problem *RoutingProblem, opts ...CallOption) (*OptimizationJobResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := problem.validate(); err != nil {
+		return nil, err
+	}
+
+	doc := *problem
+	if doc.Version == 0 {
+		doc.Version = routingProblemVersion
+	}
+	body, err := doc.MarshalJSON()
+	if err != nil {
+		return nil, errorf("failed to marshal routing problem: %w", err)
+	}
+
+	key := contextCallOptions(ctx).idempotencyKey
+	if key == "" {
+		key = idempotencyKey(body)
+		ctx = withCallOptions(ctx, []CallOption{WithIdempotencyKey(key)})
+	}
+
+	return c.submissions.do(ctx, key, c.clock, func() (*OptimizationJobResponse, error) {
+		return c.submitRoutingProblem(ctx, problem, body, key)
+	})
+}
+
+func (c *FastHttpOptimization) submitRoutingProblem(ctx context.Context, problem *RoutingProblem, body []byte,
+	key string) (*OptimizationJobResponse, error) {
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.optimizationV2APIURL.Write(buf, nil)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "submit routing problem", reqURI, nil,
+		logKeyVehicles, strconv.Itoa(len(problem.Vehicles)), logKeyLocations, strconv.Itoa(len(problem.Locations)))
+
+	resp, err := c.do(ctx, "submit routing problem", postMethod, reqURI, body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "submit routing problem", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK && resp.statusCode != http.StatusAccepted {
+		return nil, newStatusError("submit routing problem", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawOptimizationJob{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall routing problem job resp %s: %w", string(resp.body), err)
+	}
+
+	return &OptimizationJobResponse{
+		RateLimit:      resp.rateLimit,
+		Meta:           resp.meta,
+		RawResp:        resp.body,
+		ID:             respRaw.ID,
+		IdempotencyKey: key,
+	}, nil
+}
+
+// RoutingSolution calls optimized-trips/v2 job mapbox API thought fasthttp client.
+func (c *FastHttpOptimization) RoutingSolution(ctx context.Context, jobID string, opts ...CallOption) (*RoutingSolutionResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if jobID == "" {
+		return nil, validationErrorf("jobID", ConstraintRequired, "job id is required")
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.optimizationV2APIURL.Write(buf, nil, slash, url.PathEscape(jobID))
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "routing solution", reqURI, nil, logKeyJobID, jobID)
+
+	resp, err := c.do(ctx, "routing solution", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "routing solution", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	switch resp.statusCode {
+	case http.StatusAccepted:
+		return &RoutingSolutionResponse{
+			RateLimit: resp.rateLimit,
+			Meta:      resp.meta,
+			RawResp:   resp.body,
+			Status:    OptimizationJobProcessing,
+		}, nil
+	case http.StatusOK:
+	default:
+		return nil, newStatusError("get routing solution", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	solution := &RoutingSolution{}
+	if err := c.decode(resp, solution); err != nil {
+		return nil, errorf("failed to unmarshall routing solution resp %s: %w", string(resp.body), err)
+	}
+
+	return &RoutingSolutionResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Status:    OptimizationJobComplete,
+		Solution:  solution,
+	}, nil
+}
+
+// WaitForSolution polls routing problem job every poll interval until it is complete.
+func (c *FastHttpOptimization) WaitForSolution(ctx context.Context, jobID string, opts ...CallOption) (*RoutingSolution, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	ctx, cancel := c.life.bind(ctx)
+	defer cancel()
+
+	for {
+		resp, err := c.RoutingSolution(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Status == OptimizationJobComplete {
+			return resp.Solution, nil
+		}
+
+		if err := c.clock.Sleep(ctx, c.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// validate checks the problem has vehicles and every referenced location is defined.
+func (p *RoutingProblem) validate() error {
+	if len(p.Locations) == 0 {
+		return validationErrorf("Locations", ConstraintMinItems, "at least one location is required")
+	}
+	if len(p.Vehicles) == 0 {
+		return validationErrorf("Vehicles", ConstraintMinItems, "at least one vehicle is required")
+	}
+
+	locations := make(map[string]bool, len(p.Locations))
+	for _, l := range p.Locations {
+		locations[l.Name] = true
+	}
+	known := func(field, name string, optional bool) error {
+		if (optional && name == "") || locations[name] {
+			return nil
+		}
+		return validationErrorf(field, ConstraintEnum, "unknown location %q", name)
+	}
+
+	for i, v := range p.Vehicles {
+		if err := known(indexField("Vehicles", i)+".StartLocation", v.StartLocation, true); err != nil {
+			return err
+		}
+		if err := known(indexField("Vehicles", i)+".EndLocation", v.EndLocation, true); err != nil {
+			return err
+		}
+	}
+	for i, s := range p.Services {
+		if err := known(indexField("Services", i)+".Location", s.Location, false); err != nil {
+			return err
+		}
+	}
+	for i, s := range p.Shipments {
+		if err := known(indexField("Shipments", i)+".From", s.From, false); err != nil {
+			return err
+		}
+		if err := known(indexField("Shipments", i)+".To", s.To, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
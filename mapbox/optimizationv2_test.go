@@ -0,0 +1,180 @@
+package mapbox
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpOptimization_WaitForSolution(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	polls := 0
+	var body string
+
+	o := NewFastHttpOptimization(AccessToken("token"), WithClock(clock), PollInterval(5*time.Second), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			switch {
+			case string(req.Header.Method()) == "POST":
+				if !strings.HasSuffix(uri, "/optimized-trips/v2?access_token=token") {
+					t.Errorf("unexpected submit uri %s", uri)
+				}
+				body = string(req.Body())
+				resp.SetStatusCode(http.StatusAccepted)
+				resp.SetBodyString(`{"id":"job-1","status":"ok"}`)
+			case strings.Contains(uri, "/optimized-trips/v2/job-1?"):
+				polls++
+				if polls < 3 {
+					resp.SetStatusCode(http.StatusAccepted)
+					resp.SetBodyString(`{"status":"processing"}`)
+					return nil
+				}
+				resp.SetBodyString(`{"dropped":{"services":["late"],"shipments":[]},"routes":[{"vehicle":"van","stops":[
+{"type":"start","location":"depot","eta":"2024-05-01T08:00:00Z","odometer":0},
+{"type":"pickup","location":"shop","eta":"2024-05-01T08:10:00Z","odometer":3200,"wait":60,"pickups":["order-1"]},
+{"type":"dropoff","location":"home","eta":"2024-05-01T08:25:00Z","odometer":8100,"dropoffs":["order-1"]}]}]}`)
+			default:
+				t.Errorf("unexpected request %s %s", req.Header.Method(), uri)
+			}
+			return nil
+		})))
+
+	start := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	job, err := o.SubmitRoutingProblem(context.Background(), &RoutingProblem{
+		Locations: []ProblemLocation{
+			{Name: "depot", Coordinates: []float64{13.38, 52.51}},
+			{Name: "shop", Coordinates: []float64{13.40, 52.52}},
+			{Name: "home", Coordinates: []float64{13.39, 52.50}},
+		},
+		Vehicles:  []ProblemVehicle{{Name: "van", StartLocation: "depot", Capacities: map[string]int{"boxes": 10}, EarliestStart: &start}},
+		Shipments: []ProblemShipment{{Name: "order-1", From: "shop", To: "home", Size: map[string]int{"boxes": 1}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.ID != "job-1" {
+		t.Errorf("unexpected job %+v", job)
+	}
+	for _, want := range []string{`"version":1`, `"earliest_start":"2024-05-01T08:00:00Z"`, `"capacities":{"boxes":10}`,
+		`"shipments":[{"name":"order-1","from":"shop","to":"home"`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("body %s doesn't contain %s", body, want)
+		}
+	}
+	if strings.Contains(body, "latest_end") || strings.Contains(body, "services") {
+		t.Errorf("body %s has empty fields", body)
+	}
+
+	solution, err := o.WaitForSolution(context.Background(), job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if polls != 3 || len(clock.sleeps) != 2 || clock.sleeps[0] != 5*time.Second {
+		t.Errorf("polls = %d, sleeps = %v", polls, clock.sleeps)
+	}
+	if len(solution.Routes) != 1 || len(solution.Routes[0].Stops) != 3 || len(solution.Dropped.Services) != 1 {
+		t.Fatalf("unexpected solution %+v", solution)
+	}
+	stop := solution.Routes[0].Stops[1]
+	if stop.Type != StopPickup || stop.Odometer != 3200 || stop.Pickups[0] != "order-1" || !stop.ETA.Equal(start.Add(10*time.Minute)) {
+		t.Errorf("unexpected stop %+v", stop)
+	}
+}
+
+func TestRoutingProblem_Validate(t *testing.T) {
+	locations := []ProblemLocation{{Name: "depot", Coordinates: []float64{1, 1}}}
+	tests := []struct {
+		name      string
+		problem   RoutingProblem
+		wantField string
+	}{
+		{name: "no vehicles", problem: RoutingProblem{Locations: locations}, wantField: "Vehicles"},
+		{
+			name:      "unknown vehicle start",
+			problem:   RoutingProblem{Locations: locations, Vehicles: []ProblemVehicle{{Name: "van", StartLocation: "shop"}}},
+			wantField: "Vehicles[0].StartLocation",
+		},
+		{
+			name: "unknown service location",
+			problem: RoutingProblem{Locations: locations, Vehicles: []ProblemVehicle{{Name: "van"}},
+				Services: []ProblemService{{Name: "s", Location: "depot"}, {Name: "s2", Location: "shop"}}},
+			wantField: "Services[1].Location",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.problem.validate()
+			verr, ok := err.(*ValidationError)
+			if !ok || verr.Field != tt.wantField {
+				t.Errorf("validate() = %v, want field %s", err, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestFastHttpOptimization_SubmitRoutingProblemIdempotency(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	var keys []string
+	fail := false
+
+	o := NewFastHttpOptimization(AccessToken("token"), WithClock(clock), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			keys = append(keys, string(req.Header.Peek("Idempotency-Key")))
+			if fail {
+				resp.SetStatusCode(http.StatusInternalServerError)
+				return nil
+			}
+			resp.SetStatusCode(http.StatusAccepted)
+			resp.SetBodyString(`{"id":"job-` + string(rune('0'+len(keys))) + `","status":"ok"}`)
+			return nil
+		})))
+
+	problem := &RoutingProblem{
+		Locations: []ProblemLocation{{Name: "depot", Coordinates: []float64{13.38, 52.51}}},
+		Vehicles:  []ProblemVehicle{{Name: "van", StartLocation: "depot"}},
+	}
+	ctx := context.Background()
+
+	first, err := o.SubmitRoutingProblem(ctx, problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retried, err := o.SubmitRoutingProblem(ctx, problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Meta.Endpoint != string(EndpointSubmitRoutingProblem) {
+		t.Errorf("Meta.Endpoint = %s", first.Meta.Endpoint)
+	}
+	if len(keys) != 1 || keys[0] == "" || first.IdempotencyKey != keys[0] || retried.ID != first.ID {
+		t.Errorf("retried submission must be deduped, keys %v, jobs %+v %+v", keys, first, retried)
+	}
+
+	other, err := o.SubmitRoutingProblem(ctx, problem, WithIdempotencyKey("again"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[1] != "again" || other.ID == first.ID {
+		t.Errorf("new key must submit a new job, keys %v, job %+v", keys, other)
+	}
+
+	clock.now = clock.now.Add(submissionTTL)
+	if _, err := o.SubmitRoutingProblem(ctx, problem); err != nil || len(keys) != 3 {
+		t.Errorf("expired submission must be sent again, keys %v, err %v", keys, err)
+	}
+
+	fail = true
+	for i := 0; i < 2; i++ {
+		if _, err := o.SubmitRoutingProblem(ctx, problem, WithIdempotencyKey("failing")); err == nil {
+			t.Error("error expected")
+		}
+	}
+	if len(keys) != 5 {
+		t.Errorf("failed submission must not be deduped, keys %v", keys)
+	}
+}
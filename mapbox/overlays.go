@@ -0,0 +1,188 @@
+package mapbox
+
+import (
+	"bytes"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Marker sizes.
+const (
+	MarkerSmall = "s"
+	MarkerLarge = "l"
+)
+
+// Overlay is a static image overlay, see FormatOverlays.
+type Overlay interface {
+	writeOverlay(buf *bytes.Buffer) error
+}
+
+// Marker is a pin marker.
+type Marker struct {
+	Point GeoPoint
+	// Size is MarkerSmall or MarkerLarge, default to MarkerSmall.
+	Size string
+	// Label is a letter, a number from 0 to 99 or a Maki icon name, e.g. cafe.
+	Label string
+	// Color is a 3 or 6 digits hex color without #, e.g. f74e4e, mapbox default is gray.
+	Color string
+}
+
+// CustomMarker is a marker of a png or jpeg image.
+type CustomMarker struct {
+	Point GeoPoint
+	URL   string
+}
+
+// Path is a line or, if FillColor is set, a polygon.
+// Colors are 3 or 6 digits hex colors without #, zero values keep mapbox defaults.
+type Path struct {
+	// Line is encoded with LineString.Polyline, Polyline is used instead if set.
+	Line     LineString
+	Polyline string
+
+	StrokeWidth   float64
+	StrokeColor   string
+	StrokeOpacity float64
+	FillColor     string
+	FillOpacity   float64
+}
+
+// GeoJSONOverlay is a GeoJSON feature or a feature collection, simplestyle-spec properties are used for styling.
+type GeoJSONOverlay struct {
+	GeoJSON []byte
+}
+
+// FormatOverlays returns overlays in StaticImageRequest.Overlay syntax, URL encoded.
+// Overlays are drawn in order, the last one is on top.
+func FormatOverlays(overlays ...Overlay) (string, error) {
+	buf := bytes.Buffer{}
+	for i, o := range overlays {
+		if i > 0 {
+			buf.WriteByte(comma)
+		}
+		if err := o.writeOverlay(&buf); err != nil {
+			if v, ok := err.(*ValidationError); ok {
+				v.Field = indexField("", i) + "." + v.Field
+			}
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+func (m Marker) writeOverlay(buf *bytes.Buffer) error {
+	size := m.Size
+	if size == "" {
+		size = MarkerSmall
+	}
+	if size != MarkerSmall && size != MarkerLarge {
+		return validationErrorf("Size", ConstraintEnum, "invalid marker size %q", m.Size)
+	}
+	if err := validateOverlayColor("Color", m.Color); err != nil {
+		return err
+	}
+
+	buf.WriteString("pin-")
+	buf.WriteString(size)
+	if m.Label != "" {
+		buf.WriteByte('-')
+		buf.WriteString(escapeOverlay(strings.ToLower(m.Label)))
+	}
+	if m.Color != "" {
+		buf.WriteByte('+')
+		buf.WriteString(m.Color)
+	}
+	writeOverlayPoint(buf, m.Point)
+	return nil
+}
+
+func (m CustomMarker) writeOverlay(buf *bytes.Buffer) error {
+	if m.URL == "" {
+		return validationErrorf("URL", ConstraintRequired, "custom marker url is required")
+	}
+
+	buf.WriteString("url-")
+	buf.WriteString(escapeOverlay(m.URL))
+	writeOverlayPoint(buf, m.Point)
+	return nil
+}
+
+func (p Path) writeOverlay(buf *bytes.Buffer) error {
+	polyline := p.Polyline
+	if polyline == "" {
+		if len(p.Line) < 2 {
+			return validationErrorf("Line", ConstraintMinItems, "path requires at least 2 points")
+		}
+		polyline = p.Line.Polyline()
+	}
+	if err := validateOverlayColor("StrokeColor", p.StrokeColor); err != nil {
+		return err
+	}
+	if err := validateOverlayColor("FillColor", p.FillColor); err != nil {
+		return err
+	}
+
+	buf.WriteString("path")
+	if p.StrokeWidth > 0 {
+		buf.WriteByte('-')
+		buf.WriteString(strconv.FormatFloat(p.StrokeWidth, floatFormatNoExponent, -1, 64))
+	}
+	writeOverlayColor(buf, p.StrokeColor, p.StrokeOpacity)
+	writeOverlayColor(buf, p.FillColor, p.FillOpacity)
+	buf.WriteByte('(')
+	buf.WriteString(escapeOverlay(polyline))
+	buf.WriteByte(')')
+	return nil
+}
+
+func (g GeoJSONOverlay) writeOverlay(buf *bytes.Buffer) error {
+	if len(g.GeoJSON) == 0 {
+		return validationErrorf("GeoJSON", ConstraintRequired, "geojson is required")
+	}
+
+	buf.WriteString("geojson(")
+	buf.WriteString(escapeOverlay(string(g.GeoJSON)))
+	buf.WriteByte(')')
+	return nil
+}
+
+func writeOverlayPoint(buf *bytes.Buffer, p GeoPoint) {
+	buf.WriteByte('(')
+	buf.WriteString(p.String())
+	buf.WriteByte(')')
+}
+
+func writeOverlayColor(buf *bytes.Buffer, color string, opacity float64) {
+	if color == "" {
+		return
+	}
+	buf.WriteByte('+')
+	buf.WriteString(color)
+	if opacity > 0 {
+		buf.WriteByte('-')
+		buf.WriteString(strconv.FormatFloat(opacity, floatFormatNoExponent, -1, 64))
+	}
+}
+
+func validateOverlayColor(field, color string) error {
+	if color == "" {
+		return nil
+	}
+	if len(color) != 3 && len(color) != 6 {
+		return validationErrorf(field, ConstraintFormat, "invalid color %q, 3 or 6 hex digits expected", color)
+	}
+	for i := 0; i < len(color); i++ {
+		c := color[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return validationErrorf(field, ConstraintFormat, "invalid color %q, 3 or 6 hex digits expected", color)
+		}
+	}
+	return nil
+}
+
+// escapeOverlay percent-encodes overlay argument, spaces are encoded as %20 as it is a path.
+func escapeOverlay(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
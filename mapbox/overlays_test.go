@@ -0,0 +1,74 @@
+package mapbox
+
+import (
+	"testing"
+)
+
+func TestFormatOverlays(t *testing.T) {
+	line := LineString{{Lon: -77.0502, Lat: 38.8892}, {Lon: -77.0365, Lat: 38.8977}}
+
+	tests := []struct {
+		name     string
+		overlays []Overlay
+		want     string
+		err      string
+	}{
+		{
+			name:     "markers",
+			overlays: []Overlay{Marker{Point: line[0]}, Marker{Point: line[1], Size: MarkerLarge, Label: "Cafe", Color: "f74e4e"}},
+			want:     "pin-s(-77.050200,38.889200),pin-l-cafe+f74e4e(-77.036500,38.897700)",
+		},
+		{
+			name:     "custom marker",
+			overlays: []Overlay{CustomMarker{Point: line[0], URL: "https://example.com/pin 1.png"}},
+			want:     "url-https%3A%2F%2Fexample.com%2Fpin%201.png(-77.050200,38.889200)",
+		},
+		{
+			name: "path ordered under marker",
+			overlays: []Overlay{
+				Path{Polyline: "_p~iF~ps|U", StrokeWidth: 2.5, StrokeColor: "f44", StrokeOpacity: 0.5, FillColor: "00f", FillOpacity: 0.25},
+				Marker{Point: line[0], Label: "1"},
+			},
+			want: "path-2.5+f44-0.5+00f-0.25(_p~iF~ps%7CU),pin-s-1(-77.050200,38.889200)",
+		},
+		{
+			name:     "path of line",
+			overlays: []Overlay{Path{Line: line}},
+			want:     "path(" + escapeOverlay(line.Polyline()) + ")",
+		},
+		{
+			name:     "geojson",
+			overlays: []Overlay{GeoJSONOverlay{GeoJSON: []byte(`{"type":"Point","coordinates":[-77.05, 38.89]}`)}},
+			want:     "geojson(%7B%22type%22%3A%22Point%22%2C%22coordinates%22%3A%5B-77.05%2C%2038.89%5D%7D)",
+		},
+		{
+			name:     "invalid color",
+			overlays: []Overlay{Marker{Point: line[0]}, Marker{Point: line[1], Color: "#f74e4e"}},
+			err:      "[1].Color",
+		},
+		{
+			name:     "short path",
+			overlays: []Overlay{Path{Line: line[:1]}},
+			err:      "[0].Line",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatOverlays(tt.overlays...)
+			if tt.err != "" {
+				verr, ok := err.(*ValidationError)
+				if !ok || verr.Field != tt.err {
+					t.Errorf("FormatOverlays() error = %v, want field %s", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatOverlays() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
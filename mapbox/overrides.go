@@ -0,0 +1,60 @@
+package mapbox
+
+import (
+	"context"
+)
+
+type ctxKey int
+
+const (
+	ctxKeyLanguage ctxKey = iota
+	ctxKeyLabels
+	ctxKeyLogger
+	ctxKeyCallOptions
+)
+
+// WithContextLanguage returns ctx carrying language for requests made with it.
+// It is used by geocode requests which have no Language set, explicit request value always wins.
+func WithContextLanguage(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, ctxKeyLanguage, language)
+}
+
+// requestLanguage returns language if set or one attached to ctx with WithContextLanguage.
+func requestLanguage(ctx context.Context, language string) string {
+	if language != "" {
+		return language
+	}
+	l, _ := ctx.Value(ctxKeyLanguage).(string)
+	return l
+}
+
+// WithContextLabels returns ctx carrying labels, e.g. tenant or product, reported in AccessRecord.Labels
+// of calls made with it. Labels are merged with ones already attached to ctx.
+func WithContextLabels(ctx context.Context, labels map[string]string) context.Context {
+	parent := contextLabels(ctx)
+	merged := make(map[string]string, len(parent)+len(labels))
+	for k, v := range parent {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, ctxKeyLabels, merged)
+}
+
+// contextLabels returns labels attached with WithContextLabels, it must not be modified.
+func contextLabels(ctx context.Context) map[string]string {
+	l, _ := ctx.Value(ctxKeyLabels).(map[string]string)
+	return l
+}
+
+// WithContextLogger returns ctx carrying logger for requests made with it,
+// e.g. to route logs of a batch job. It takes precedence over Log and RequestLogger options.
+func WithContextLogger(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, ctxKeyLogger, l)
+}
+
+func contextLogger(ctx context.Context) Logger {
+	l, _ := ctx.Value(ctxKeyLogger).(Logger)
+	return l
+}
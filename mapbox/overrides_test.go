@@ -0,0 +1,41 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestWithContextLanguage(t *testing.T) {
+	var gotLanguage string
+	c := NewFastHttpGeocoder(HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		gotLanguage = string(req.URI().QueryArgs().Peek(language))
+		resp.SetBody(testRespBody)
+		return nil
+	})))
+
+	ctx := WithContextLanguage(context.Background(), "de")
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		language string
+		want     string
+	}{
+		{name: "no language", ctx: context.Background()},
+		{name: "context language", ctx: ctx, want: "de"},
+		{name: "request language wins", ctx: ctx, language: "fr", want: "fr"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &ReverseGeocodeRequest{GeoPoint: GeoPoint{Lon: -77.036547, Lat: 38.897675}, Language: tt.language}
+			if _, err := c.ReverseGeocode(tt.ctx, req); err != nil {
+				t.Fatal(err)
+			}
+			if gotLanguage != tt.want {
+				t.Errorf("language = %q, want %q", gotLanguage, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package mapbox
 import (
 	"bytes"
 	"sync"
+
+	"github.com/mailru/easyjson/jlexer"
 )
 
 type noCopy struct{}
@@ -29,3 +31,93 @@ func (pool *stringsBufferPool) releaseStringsBuilder(b *bytes.Buffer) {
 	b.Reset()
 	pool.p.Put(b)
 }
+
+// ReuseContexts pools feature context slices between geocode responses, they dominate allocations
+// of responses with many features. Pooled slices are reused only after GeocodeResponse.Release.
+// default to false.
+func ReuseContexts(reuse bool) Option {
+	return func(c config) config {
+		c.contexts = nil
+		if reuse {
+			c.contexts = newContextPool()
+		}
+		return c
+	}
+}
+
+type contextPool struct {
+	noCopy noCopy
+	p      sync.Pool
+}
+
+func newContextPool() *contextPool {
+	return &contextPool{p: sync.Pool{New: func() interface{} {
+		s := make([]Context, 0, 8)
+		return &s
+	}}}
+}
+
+func (pool *contextPool) acquireContexts() []Context {
+	return *pool.p.Get().(*[]Context)
+}
+
+func (pool *contextPool) releaseContexts(s []Context) {
+	if cap(s) == 0 {
+		return
+	}
+	// drop strings references until the slice is reused
+	s = s[:cap(s)]
+	for i := range s {
+		s[i] = Context{}
+	}
+	s = s[:0]
+	pool.p.Put(&s)
+}
+
+// unmarshalGeocode decodes geocode response features taking context slices from the pool,
+// query decodes query field which differs for forward and reverse geocoding.
+func (pool *contextPool) unmarshalGeocode(body []byte, features *[]Feature, query func(in *jlexer.Lexer)) error {
+	return unmarshalGeocodeFields(body, func(in *jlexer.Lexer) {
+		*features = pool.unmarshalFeatures(in)
+	}, query)
+}
+
+func (pool *contextPool) unmarshalFeatures(in *jlexer.Lexer) []Feature {
+	if in.IsNull() {
+		in.Skip()
+		return nil
+	}
+
+	var features []Feature
+
+	in.Delim('[')
+	for !in.IsDelim(']') {
+		// generated decoder reuses non nil slice
+		f := Feature{Context: pool.acquireContexts()}
+		f.UnmarshalEasyJSON(in)
+		if len(f.Context) == 0 {
+			pool.releaseContexts(f.Context)
+			f.Context = nil
+		}
+		features = append(features, f)
+		in.WantComma()
+	}
+	in.Delim(']')
+
+	return features
+}
+
+func unmarshalForwardQuery(q *[]string) func(in *jlexer.Lexer) {
+	return func(in *jlexer.Lexer) {
+		if in.IsNull() {
+			in.Skip()
+			return
+		}
+		in.Delim('[')
+		for !in.IsDelim(']') {
+			*q = append(*q, in.String())
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+}
@@ -0,0 +1,66 @@
+package mapbox
+
+import (
+	"strings"
+)
+
+// TypesPreset names a recommended set of geocode/v5 feature types of a product surface.
+type TypesPreset string
+
+const (
+	// TypesAddressEntry suits checkout and signup address forms.
+	TypesAddressEntry TypesPreset = "address-entry"
+	// TypesStoreLocator suits searching for a shop or a place to meet.
+	TypesStoreLocator TypesPreset = "store-locator"
+	// TypesCityPicker suits choosing a city, e.g. of a user profile.
+	TypesCityPicker TypesPreset = "city-picker"
+	// TypesDeliveryArea suits checking whether an area is served.
+	TypesDeliveryArea TypesPreset = "delivery-area"
+)
+
+// defaultTypesPresets are built-in presets used in every country.
+var defaultTypesPresets = map[TypesPreset][]string{
+	TypesAddressEntry: {PlaceTypeAddress, PlaceTypePostcode, PlaceTypePlace},
+	TypesStoreLocator: {PlaceTypePOI, PlaceTypeAddress},
+	TypesCityPicker:   {PlaceTypePlace, PlaceTypeLocality},
+	TypesDeliveryArea: {PlaceTypePostcode, PlaceTypeNeighborhood, PlaceTypeLocality, PlaceTypePlace},
+}
+
+// TypesPresetTypes overrides types of preset, a built-in or a new one, in country,
+// an ISO 3166 alpha 2 code, or in every country if country is empty.
+// Country overrides are used if request Country is the single country.
+func TypesPresetTypes(preset TypesPreset, country string, types []string) Option {
+	return func(c config) config {
+		presets := make(map[string][]string, len(c.typesPresets)+1)
+		for k, v := range c.typesPresets {
+			presets[k] = v
+		}
+		presets[typesPresetKey(preset, country)] = append([]string(nil), types...)
+		c.typesPresets = presets
+		return c
+	}
+}
+
+func typesPresetKey(preset TypesPreset, country string) string {
+	if country == "" {
+		return string(preset)
+	}
+	return string(preset) + slash + strings.ToLower(country)
+}
+
+// presetTypes resolves preset types preferring country override, then an override for every country,
+// then the built-in preset.
+func (c *config) presetTypes(preset TypesPreset, country string) ([]string, error) {
+	if country != "" && !strings.Contains(country, string(comma)) {
+		if types, ok := c.typesPresets[typesPresetKey(preset, strings.TrimSpace(country))]; ok {
+			return types, nil
+		}
+	}
+	if types, ok := c.typesPresets[typesPresetKey(preset, "")]; ok {
+		return types, nil
+	}
+	if types, ok := defaultTypesPresets[preset]; ok {
+		return types, nil
+	}
+	return nil, validationErrorf("TypesPreset", ConstraintEnum, "unknown types preset %q", preset)
+}
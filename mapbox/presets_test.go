@@ -0,0 +1,71 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestTypesPreset(t *testing.T) {
+	var uri string
+	g := NewFastHttpGeocoder(AccessToken("token"), OmitDefaultParams(true),
+		TypesPresetTypes(TypesAddressEntry, "GB", []string{"address", "postcode"}),
+		TypesPresetTypes("pickup-point", "", []string{"poi"}),
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(`{"type":"FeatureCollection","query":["a"],"features":[]}`)
+			return nil
+		})))
+
+	tests := []struct {
+		name    string
+		req     ForwardGeocodeRequest
+		wantURI string
+		wantErr bool
+	}{
+		{
+			name:    "built-in",
+			req:     ForwardGeocodeRequest{SearchText: "a", TypesPreset: TypesAddressEntry},
+			wantURI: "?access_token=token&types=address,postcode,place",
+		},
+		{
+			name:    "country override",
+			req:     ForwardGeocodeRequest{SearchText: "a", Country: "gb", TypesPreset: TypesAddressEntry},
+			wantURI: "?access_token=token&country=gb&types=address,postcode",
+		},
+		{
+			name:    "many countries use default",
+			req:     ForwardGeocodeRequest{SearchText: "a", Country: "gb,ie", TypesPreset: TypesAddressEntry},
+			wantURI: "?access_token=token&country=gb,ie&types=address,postcode,place",
+		},
+		{
+			name:    "custom preset",
+			req:     ForwardGeocodeRequest{SearchText: "a", TypesPreset: "pickup-point"},
+			wantURI: "?access_token=token&types=poi",
+		},
+		{
+			name:    "request types take precedence",
+			req:     ForwardGeocodeRequest{SearchText: "a", Types: []string{"place"}, TypesPreset: TypesStoreLocator},
+			wantURI: "?access_token=token&types=place",
+		},
+		{
+			name:    "unknown preset",
+			req:     ForwardGeocodeRequest{SearchText: "a", TypesPreset: "unknown"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := g.ForwardGeocode(context.Background(), &tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ForwardGeocode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !strings.HasSuffix(uri, tt.wantURI) {
+				t.Errorf("uri = %s, want suffix %s", uri, tt.wantURI)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package mapbox
+
+import (
+	"sync"
+	"time"
+)
+
+// maxRecordedBody limits recorded request and response body size.
+const maxRecordedBody = 4 << 10
+
+// redacted replaces access token values in recorded calls and errors.
+const redacted = "redacted"
+
+// RecordedCall is a sanitized copy of an API call, see RecordCalls.
+type RecordedCall struct {
+	Time     time.Time
+	Endpoint string
+	Method   string
+	// URI is the request URI with access token redacted.
+	URI string
+	// RequestBody and ResponseBody are truncated to 4KB.
+	RequestBody  []byte
+	StatusCode   int
+	RequestID    string
+	ResponseBody []byte
+	Duration     time.Duration
+	Err          error
+}
+
+// RecordCalls keeps last n calls in memory to debug production incidents with RecentCalls.
+// default to 0, calls are not recorded.
+func RecordCalls(n int) Option {
+	return func(c config) config {
+		c.recordCalls = n
+		return c
+	}
+}
+
+// callRecorder is a fixed size ring buffer of calls, it is shared by config copies.
+type callRecorder struct {
+	mu    sync.Mutex
+	calls []RecordedCall
+	next  int
+	full  bool
+}
+
+func newCallRecorder(n int) *callRecorder {
+	return &callRecorder{calls: make([]RecordedCall, n)}
+}
+
+func (r *callRecorder) add(call RecordedCall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls[r.next] = call
+	r.next = (r.next + 1) % len(r.calls)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// recent returns calls from the oldest to the newest.
+func (r *callRecorder) recent() []RecordedCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.full {
+		return append([]RecordedCall(nil), r.calls[:r.next]...)
+	}
+	calls := make([]RecordedCall, 0, len(r.calls))
+	calls = append(calls, r.calls[r.next:]...)
+	return append(calls, r.calls[:r.next]...)
+}
+
+// RecentCalls returns up to RecordCalls last calls from the oldest to the newest.
+func (c *config) RecentCalls() []RecordedCall {
+	if c.recorder == nil {
+		return nil
+	}
+	return c.recorder.recent()
+}
+
+// recordCall adds sanitized call to the recorder if set.
+func (c *config) recordCall(op string, method, reqURI, body []byte, started time.Time, resp *rawResponse, err error) {
+	if c.recorder == nil {
+		return
+	}
+
+	call := RecordedCall{
+		Time:        started,
+		Endpoint:    op,
+		Method:      string(method),
+		URI:         c.redactURI(reqURI),
+		RequestBody: truncateBody(body),
+		Duration:    c.clock.Now().Sub(started),
+		Err:         err,
+	}
+	if resp != nil {
+		call.StatusCode = resp.statusCode
+		call.RequestID = resp.meta.RequestID
+		call.ResponseBody = truncateBody(resp.body)
+	}
+
+	c.recorder.add(call)
+}
+
+// redactURI replaces access token in request URI.
+func (c *config) redactURI(reqURI []byte) string {
+	return redactAccessToken(string(reqURI))
+}
+
+func truncateBody(body []byte) []byte {
+	if len(body) > maxRecordedBody {
+		body = body[:maxRecordedBody]
+	}
+	return append([]byte(nil), body...)
+}
@@ -0,0 +1,55 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestRecentCalls(t *testing.T) {
+	g := NewFastHttpGeocoderV6(AccessToken("secret"), RecordCalls(2), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			resp.SetStatusCode(fasthttp.StatusOK)
+			resp.SetBodyString(testV6RespBody)
+			return nil
+		})))
+
+	if calls := g.RecentCalls(); len(calls) != 0 {
+		t.Fatalf("unexpected calls %+v", calls)
+	}
+
+	for _, q := range []string{"a", "b", "c"} {
+		if _, err := g.ForwardGeocodeV6(context.Background(), &ForwardGeocodeV6Request{Query: q}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	calls := g.RecentCalls()
+	if len(calls) != 2 {
+		t.Fatalf("len(calls) = %d, want 2", len(calls))
+	}
+	for i, want := range []string{"q=b", "q=c"} {
+		call := calls[i]
+		switch {
+		case !strings.HasSuffix(call.URI, "/search/geocode/v6/forward?access_token=redacted&"+want):
+			t.Errorf("unexpected uri %s", call.URI)
+		case strings.Contains(call.URI, "secret"):
+			t.Errorf("access token is not redacted %s", call.URI)
+		case call.Method != "GET" || call.StatusCode != fasthttp.StatusOK || string(call.ResponseBody) != testV6RespBody:
+			t.Errorf("unexpected call %+v", call)
+		}
+	}
+
+	if calls := NewFastHttpGeocoderV6().RecentCalls(); calls != nil {
+		t.Errorf("calls are not recorded by default, got %+v", calls)
+	}
+}
+
+func TestTruncateBody(t *testing.T) {
+	body := []byte(strings.Repeat("a", maxRecordedBody+1))
+	if got := truncateBody(body); len(got) != maxRecordedBody {
+		t.Errorf("len(truncateBody()) = %d, want %d", len(got), maxRecordedBody)
+	}
+}
@@ -0,0 +1,52 @@
+package mapbox
+
+const (
+	// initialRouteSimplification is a first simplification tolerance in meters tried when route doesn't fit URL,
+	// it is doubled until it fits.
+	initialRouteSimplification = 5
+)
+
+// RouteImageRequest returns a copy of base with route drawn over, origin and destination markers
+// and viewport fit to the route. Route geometry is simplified as little as needed to fit URL length limit.
+// Route must have geometry of any format, polylines are decoded, base sets style, size and other image params.
+func (c *FastHttpStaticImages) RouteImageRequest(route Route, base StaticImageRequest) (*StaticImageRequest, error) {
+	line := route.Geometry.LineString()
+	if len(line) < 2 {
+		return nil, validationErrorf("Geometry", ConstraintRequired, "route geometry with at least 2 points is required")
+	}
+
+	req := base
+	req.Center, req.Bbox, req.Auto = nil, nil, true
+
+	origin := Marker{Point: line[0], Label: "a", Color: "2ecc71"}
+	destination := Marker{Point: line[len(line)-1], Label: "b", Color: "e74c3c"}
+
+	simplified := line
+	for tolerance := float64(initialRouteSimplification); ; tolerance *= 2 {
+		overlay, err := FormatOverlays(
+			Path{Line: simplified, StrokeWidth: 5, StrokeColor: "3bb2d0", StrokeOpacity: 0.8},
+			origin, destination,
+		)
+		if err != nil {
+			return nil, err
+		}
+		req.Overlay = overlay
+		if base.Overlay != "" {
+			req.Overlay += string(comma) + base.Overlay
+		}
+
+		n, err := c.EstimateURLLength(&req)
+		if err != nil {
+			return nil, err
+		}
+		if n <= maxStaticImageURLLen {
+			return &req, nil
+		}
+		if len(simplified) == 2 {
+			return nil, validationErrorf("", ConstraintMaxLength, "static image URL length %d exceeds %d even with route simplified",
+				n, maxStaticImageURLLen)
+		}
+
+		simplified = line.Simplify(tolerance)
+	}
+}
@@ -0,0 +1,50 @@
+package mapbox
+
+import (
+	"math"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestFastHttpStaticImages_RouteImageRequest(t *testing.T) {
+	c := NewFastHttpStaticImages(PublicAccessToken("pk.public"))
+	base := StaticImageRequest{StyleID: "streets-v11", Width: 600, Height: 400, Zoom: 10, Center: &GeoPoint{}}
+
+	short := lineRoute(0, 0, []float64{-77.0502, 38.8892}, []float64{-77.0431, 38.8921}, []float64{-77.0365, 38.8977})
+	req, err := c.RouteImageRequest(short, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "path-5+3bb2d0-0.8(" + url.QueryEscape(short.Geometry.LineString().Polyline()) + "),pin-s-a+2ecc71(-77.050200,38.889200)," +
+		"pin-s-b+e74c3c(-77.036500,38.897700)"
+	if req.Overlay != want || !req.Auto || req.Center != nil || req.StyleID != base.StyleID {
+		t.Errorf("unexpected request %+v", req)
+	}
+	if base.Center == nil || base.Overlay != "" {
+		t.Error("base request must not be changed")
+	}
+
+	// a wiggly route too long to fit URL as is
+	coords := make([][]float64, 3000)
+	for i := range coords {
+		coords[i] = []float64{float64(i) * 0.001, 0.00005 * math.Sin(float64(i))}
+	}
+	long := lineRoute(0, 0, coords...)
+	req, err = c.RouteImageRequest(long, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := c.EstimateURLLength(req); n > maxStaticImageURLLen || !strings.HasPrefix(req.Overlay, "path-") {
+		t.Errorf("URL length = %d, overlay %s", n, req.Overlay)
+	}
+
+	polyline := Route{Geometry: RouteGeometry{Polyline: short.Geometry.LineString().Polyline()}}
+	if req, err := c.RouteImageRequest(polyline, base); err != nil || req.Overlay != want {
+		t.Errorf("RouteImageRequest(polyline) = %+v, %v", req, err)
+	}
+
+	if _, err := c.RouteImageRequest(Route{Geometry: RouteGeometry{Polyline: "_p~iF~ps|U"}}, base); err == nil {
+		t.Error("single point geometry error expected")
+	}
+}
@@ -0,0 +1,394 @@
+package mapbox
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	q            = "q"
+	sessionToken = "session_token"
+)
+
+// SuggestRequest describes search box suggest request.
+type SuggestRequest struct {
+	// Query is a user input, e.g. a partial address or a POI name.
+	Query string
+	// SessionToken groups suggest and retrieve calls of a single user search session for billing,
+	// see NewSearchSessionToken.
+	SessionToken string
+	// Proximity biases results toward the point.
+	Proximity *GeoPoint
+	// Types limits results to feature types, e.g. poi, address.
+	Types []string
+	// Language overrides context language, see WithContextLanguage.
+	Language string
+	// Country limits results to ISO 3166 alpha 2 country codes, comma separated.
+	Country string
+	// Limit of suggestions, mapbox default is 5.
+	Limit int
+}
+
+// RetrieveRequest describes search box retrieve request.
+type RetrieveRequest struct {
+	// MapboxID of a suggestion.
+	MapboxID string
+	// SessionToken must be the one suggestion was got with.
+	SessionToken string
+}
+
+// CategorySearchRequest describes search box category search request.
+type CategorySearchRequest struct {
+	// Category is a canonical category id, e.g. CategoryCoffee, see ValidateCategory.
+	Category string
+	// Proximity biases results toward the point.
+	Proximity *GeoPoint
+	// Bbox limits results to minLon,minLat,maxLon,maxLat box.
+	Bbox []float64
+	// Language overrides context language, see WithContextLanguage.
+	Language string
+	// Country limits results to ISO 3166 alpha 2 country codes, comma separated.
+	Country string
+	// Limit of features, mapbox default is 10.
+	Limit int
+}
+
+// Suggestion is a search box suggest result, it has no coordinates and must be retrieved to get them.
+type Suggestion struct {
+	MapboxID       string    `json:"mapbox_id"`
+	FeatureType    string    `json:"feature_type"`
+	Name           string    `json:"name"`
+	NamePreferred  string    `json:"name_preferred,omitempty"`
+	Address        string    `json:"address,omitempty"`
+	FullAddress    string    `json:"full_address,omitempty"`
+	PlaceFormatted string    `json:"place_formatted,omitempty"`
+	Context        ContextV6 `json:"context"`
+	Language       string    `json:"language,omitempty"`
+	Maki           string    `json:"maki,omitempty"`
+	POICategory    []string  `json:"poi_category,omitempty"`
+	// Distance in meters from Proximity if set.
+	Distance float64 `json:"distance,omitempty"`
+}
+
+// SearchBoxFeature is a search box retrieve result.
+type SearchBoxFeature struct {
+	Type       string              `json:"type"`
+	Geometry   Geometry            `json:"geometry"`
+	Properties SearchBoxProperties `json:"properties"`
+}
+
+// SearchBoxProperties describes a retrieved place.
+type SearchBoxProperties struct {
+	MapboxID       string        `json:"mapbox_id"`
+	FeatureType    string        `json:"feature_type"`
+	Name           string        `json:"name"`
+	NamePreferred  string        `json:"name_preferred,omitempty"`
+	Address        string        `json:"address,omitempty"`
+	FullAddress    string        `json:"full_address,omitempty"`
+	PlaceFormatted string        `json:"place_formatted,omitempty"`
+	Coordinates    CoordinatesV6 `json:"coordinates"`
+	Context        ContextV6     `json:"context"`
+	Language       string        `json:"language,omitempty"`
+	Maki           string        `json:"maki,omitempty"`
+	POICategory    []string      `json:"poi_category,omitempty"`
+	BoundingBox    []float64     `json:"bbox,omitempty"`
+}
+
+// easyjson:json
+type rawSuggestResp struct {
+	Suggestions []Suggestion `json:"suggestions"`
+	Attribution string       `json:"attribution"`
+}
+
+// easyjson:json
+type rawRetrieveResp struct {
+	Type        string             `json:"type"`
+	Features    []SearchBoxFeature `json:"features"`
+	Attribution string             `json:"attribution"`
+}
+
+// SuggestResponse wraps search box suggestions.
+type SuggestResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Suggestions []Suggestion
+	Attribution string
+}
+
+// RetrieveResponse wraps retrieved search box features.
+type RetrieveResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Features []SearchBoxFeature
+	// Filtered is the number of features dropped by GeofenceResults
+	Filtered    int
+	Attribution string
+}
+
+// CategorySearchResponse wraps POIs found by category.
+type CategorySearchResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Features []SearchBoxFeature
+	// Filtered is the number of features dropped by GeofenceResults
+	Filtered    int
+	Attribution string
+}
+
+// SearchBox covers interactive search box mapbox API, e.g. for autocomplete UI.
+type SearchBox interface {
+	// Suggest calls search box suggest mapbox API
+	Suggest(ctx context.Context, req *SuggestRequest, opts ...CallOption) (*SuggestResponse, error)
+	// Retrieve calls search box retrieve mapbox API
+	Retrieve(ctx context.Context, req *RetrieveRequest, opts ...CallOption) (*RetrieveResponse, error)
+	// CategorySearch calls search box category mapbox API
+	CategorySearch(ctx context.Context, req *CategorySearchRequest, opts ...CallOption) (*CategorySearchResponse, error)
+}
+
+// FastHttpSearchBox is a fasthttp SearchBox implementation
+type FastHttpSearchBox struct {
+	config
+
+	suggestAPIURL  EndpointURL
+	retrieveAPIURL EndpointURL
+	categoryAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// NewSearchSessionToken returns a random UUIDv4 to be used as SessionToken.
+func NewSearchSessionToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errorf("failed to generate session token: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// Suggest calls search box suggest mapbox API thought fasthttp client.
+func (c *FastHttpSearchBox) Suggest(ctx context.Context, req *SuggestRequest, opts ...CallOption) (*SuggestResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if req.Query == "" {
+		return nil, validationErrorf("Query", ConstraintRequired, "query is required")
+	}
+	if req.SessionToken == "" {
+		return nil, validationErrorf("SessionToken", ConstraintRequired, "session token is required")
+	}
+
+	var cacheKey string
+	if c.suggests != nil {
+		cacheKey = suggestCacheKey(req, requestLanguage(ctx, req.Language))
+		if !contextCallOptions(ctx).noCache {
+			if resp, ok := c.suggests.get(cacheKey, c.clock.Now()); ok {
+				return resp, nil
+			}
+		}
+	}
+
+	values := make(map[string]string, 7)
+	values[q] = url.QueryEscape(req.Query)
+	values[sessionToken] = url.QueryEscape(req.SessionToken)
+	if req.Proximity != nil {
+		values[proximity] = fmt.Sprintf("%f,%f", req.Proximity.Lon, req.Proximity.Lat)
+	}
+	if len(req.Types) > 0 {
+		values[types] = strings.Join(req.Types, ",")
+	}
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
+	}
+	if req.Country != "" {
+		values[country] = req.Country
+	}
+	if req.Limit != 0 {
+		values[limit] = strconv.Itoa(req.Limit)
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.suggestAPIURL.Write(buf, values)
+
+	reqURI := buf.Bytes()
+
+	logValues := make(map[string]string, len(values))
+	for k, v := range values {
+		if k != q {
+			logValues[k] = v
+		}
+	}
+	c.logRequest(ctx, "suggest", reqURI, logValues, logKeySearchTextLen, strconv.Itoa(len(req.Query)))
+
+	resp, err := c.searchBox(ctx, "suggest", reqURI)
+	if err != nil {
+		return nil, err
+	}
+
+	respRaw := rawSuggestResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall suggest resp %s: %w", string(resp.body), err)
+	}
+
+	suggest := &SuggestResponse{
+		RateLimit:   resp.rateLimit,
+		Meta:        resp.meta,
+		RawResp:     resp.body,
+		Suggestions: respRaw.Suggestions,
+		Attribution: respRaw.Attribution,
+	}
+	if c.suggests != nil {
+		c.suggests.put(cacheKey, suggest, c.clock.Now())
+	}
+
+	return suggest, nil
+}
+
+// Retrieve calls search box retrieve mapbox API thought fasthttp client.
+func (c *FastHttpSearchBox) Retrieve(ctx context.Context, req *RetrieveRequest, opts ...CallOption) (*RetrieveResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if req.MapboxID == "" {
+		return nil, validationErrorf("MapboxID", ConstraintRequired, "mapbox id is required")
+	}
+	if req.SessionToken == "" {
+		return nil, validationErrorf("SessionToken", ConstraintRequired, "session token is required")
+	}
+
+	values := map[string]string{sessionToken: url.QueryEscape(req.SessionToken)}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.retrieveAPIURL.Write(buf, values, url.PathEscape(req.MapboxID))
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "retrieve", reqURI, values, logKeyFeature, req.MapboxID)
+
+	resp, err := c.searchBox(ctx, "retrieve", reqURI)
+	if err != nil {
+		return nil, err
+	}
+
+	respRaw := rawRetrieveResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall retrieve resp %s: %w", string(resp.body), err)
+	}
+
+	features, filtered := c.geofenceSearchBox(ctx, "retrieve", respRaw.Features)
+
+	return &RetrieveResponse{
+		RateLimit:   resp.rateLimit,
+		Meta:        resp.meta,
+		RawResp:     resp.body,
+		Features:    features,
+		Filtered:    filtered,
+		Attribution: respRaw.Attribution,
+	}, nil
+}
+
+// CategorySearch calls search box category mapbox API thought fasthttp client.
+// It needs no session token, every call is billed separately.
+func (c *FastHttpSearchBox) CategorySearch(ctx context.Context, req *CategorySearchRequest, opts ...CallOption) (*CategorySearchResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if req.Category == "" {
+		return nil, validationErrorf("Category", ConstraintRequired, "category is required")
+	}
+
+	values := make(map[string]string, 5)
+	if req.Proximity != nil {
+		values[proximity] = fmt.Sprintf("%f,%f", req.Proximity.Lon, req.Proximity.Lat)
+	}
+	if len(req.Bbox) == 4 {
+		values[bbox] = fmt.Sprintf("%f,%f,%f,%f", req.Bbox[0], req.Bbox[1], req.Bbox[2], req.Bbox[3])
+	}
+	if l := requestLanguage(ctx, req.Language); l != "" {
+		values[language] = l
+	}
+	if req.Country != "" {
+		values[country] = req.Country
+	}
+	if req.Limit != 0 {
+		values[limit] = strconv.Itoa(req.Limit)
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.categoryAPIURL.Write(buf, values, url.PathEscape(req.Category))
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "category search", reqURI, values, logKeyCategory, req.Category)
+
+	resp, err := c.searchBox(ctx, "category search", reqURI)
+	if err != nil {
+		return nil, err
+	}
+
+	respRaw := rawRetrieveResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall category search resp %s: %w", string(resp.body), err)
+	}
+
+	features, filtered := c.geofenceSearchBox(ctx, "category search", respRaw.Features)
+
+	return &CategorySearchResponse{
+		RateLimit:   resp.rateLimit,
+		Meta:        resp.meta,
+		RawResp:     resp.body,
+		Features:    features,
+		Filtered:    filtered,
+		Attribution: respRaw.Attribution,
+	}, nil
+}
+
+func (c *FastHttpSearchBox) searchBox(ctx context.Context, op string, reqURI []byte) (*rawResponse, error) {
+	resp, err := c.do(ctx, op, getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, op, resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError(op, string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	return resp, nil
+}
+
+// NewFastHttpSearchBox creates fasthttp SearchBox client.
+func NewFastHttpSearchBox(opts ...Option) *FastHttpSearchBox {
+	c := FastHttpSearchBox{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.suggestAPIURL = c.endpointURL("/search/searchbox/v1/suggest")
+	c.retrieveAPIURL = c.endpointURL("/search/searchbox/v1/retrieve/")
+	c.categoryAPIURL = c.endpointURL("/search/searchbox/v1/category/")
+
+	return &c
+}
@@ -0,0 +1,120 @@
+package mapbox
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+const testSuggestRespBody = `{"suggestions":[{"name":"Lincoln Memorial","mapbox_id":"dXJuOm1ieHBvaTox","feature_type":"poi",
+"address":"2 Lincoln Memorial Cir NW","full_address":"2 Lincoln Memorial Cir NW, Washington, District of Columbia 20002, United States of America",
+"place_formatted":"Washington, District of Columbia 20002, United States of America",
+"context":{"country":{"name":"United States of America","country_code":"US","country_code_alpha_3":"USA"},"place":{"id":"dXJuOm1ieHBsYzo4","name":"Washington"}},
+"language":"en","maki":"monument","poi_category":["monument","historic site"],"distance":1200.5}],
+"attribution":"© 2023 Mapbox and its suppliers.","url":""}`
+
+const testRetrieveRespBody = `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[-77.050137,38.889246]},
+"properties":{"name":"Lincoln Memorial","mapbox_id":"dXJuOm1ieHBvaTox","feature_type":"poi","full_address":"2 Lincoln Memorial Cir NW, Washington",
+"coordinates":{"latitude":38.889246,"longitude":-77.050137,"routable_points":[{"name":"Entrance","latitude":38.8891,"longitude":-77.0496}]},
+"context":{"country":{"name":"United States of America","country_code":"US","country_code_alpha_3":"USA"}},"maki":"monument","poi_category":["monument"]}}],
+"attribution":"© 2023 Mapbox and its suppliers."}`
+
+func TestFastHttpSearchBox(t *testing.T) {
+	var uris []string
+	s := NewFastHttpSearchBox(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			uris = append(uris, uri)
+			if strings.Contains(uri, "/retrieve/") {
+				resp.SetBodyString(testRetrieveRespBody)
+			} else {
+				resp.SetBodyString(testSuggestRespBody)
+			}
+			return nil
+		})))
+
+	suggest, err := s.Suggest(context.Background(), &SuggestRequest{
+		Query: "lincoln memorial & co", SessionToken: "session", Proximity: &GeoPoint{Lon: -77, Lat: 38.9}, Types: []string{"poi"}, Limit: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(suggest.Suggestions) != 1 {
+		t.Fatalf("unexpected suggestions %+v", suggest.Suggestions)
+	}
+	sg := suggest.Suggestions[0]
+	if sg.MapboxID != "dXJuOm1ieHBvaTox" || sg.Maki != "monument" || len(sg.POICategory) != 2 || sg.Context.Country.CountryCode != "US" {
+		t.Errorf("unexpected suggestion %+v", sg)
+	}
+
+	retrieve, err := s.Retrieve(context.Background(), &RetrieveRequest{MapboxID: sg.MapboxID, SessionToken: "session"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retrieve.Features) != 1 {
+		t.Fatalf("unexpected features %+v", retrieve.Features)
+	}
+	p := retrieve.Features[0].Properties
+	if p.Coordinates.GeoPoint() != (GeoPoint{Lon: -77.050137, Lat: 38.889246}) || len(p.Coordinates.RoutablePoints) != 1 {
+		t.Errorf("unexpected properties %+v", p)
+	}
+
+	wantURIs := []string{
+		"/search/searchbox/v1/suggest?access_token=token&limit=3&proximity=-77.000000,38.900000&q=lincoln+memorial+%26+co&session_token=session&types=poi",
+		"/search/searchbox/v1/retrieve/dXJuOm1ieHBvaTox?access_token=token&session_token=session",
+	}
+	for i, want := range wantURIs {
+		if !strings.HasSuffix(uris[i], want) {
+			t.Errorf("uri = %s, want suffix %s", uris[i], want)
+		}
+	}
+
+	if _, err := s.Suggest(context.Background(), &SuggestRequest{Query: "a"}); err == nil {
+		t.Error("session token required error expected")
+	}
+	if _, err := s.Retrieve(context.Background(), &RetrieveRequest{SessionToken: "session"}); err == nil {
+		t.Error("mapbox id required error expected")
+	}
+}
+
+func TestNewSearchSessionToken(t *testing.T) {
+	token, err := NewSearchSessionToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(token) {
+		t.Errorf("unexpected token %s", token)
+	}
+}
+
+func TestFastHttpSearchBox_CategorySearch(t *testing.T) {
+	var uri string
+	s := NewFastHttpSearchBox(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(testRetrieveRespBody)
+			return nil
+		})))
+
+	resp, err := s.CategorySearch(context.Background(), &CategorySearchRequest{
+		Category: CategoryChargingStation, Bbox: []float64{-77.1, 38.8, -77, 38.9}, Limit: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "/search/searchbox/v1/category/charging_station?access_token=token&bbox=-77.100000,38.800000,-77.000000,38.900000&limit=5"
+	if !strings.HasSuffix(uri, want) {
+		t.Errorf("uri = %s, want suffix %s", uri, want)
+	}
+	if len(resp.Features) != 1 || resp.Features[0].Properties.Maki != "monument" {
+		t.Errorf("unexpected features %+v", resp.Features)
+	}
+
+	if _, err := s.CategorySearch(context.Background(), &CategorySearchRequest{}); err == nil {
+		t.Error("category required error expected")
+	}
+}
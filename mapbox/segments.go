@@ -0,0 +1,38 @@
+package mapbox
+
+// RoadSegment is a way between two consecutive OSM nodes of a route,
+// e.g. to join routes and matched traces against road segment tables.
+type RoadSegment struct {
+	From int64
+	To   int64
+}
+
+// Segments returns road segments in order, the segment i metadata is the i-th element of the other annotations,
+// e.g. Congestion[i]. It is empty if AnnotationNodes was not requested.
+func (a *LegAnnotation) Segments() []RoadSegment {
+	if a == nil || len(a.Nodes) < 2 {
+		return nil
+	}
+
+	segments := make([]RoadSegment, len(a.Nodes)-1)
+	for i := range segments {
+		segments[i] = RoadSegment{From: a.Nodes[i], To: a.Nodes[i+1]}
+	}
+	return segments
+}
+
+// SegmentCongestion maps road segments of the leg to their congestion levels,
+// it is empty unless both AnnotationNodes and AnnotationCongestion were requested.
+// The level of the last traversal is kept if the leg passes a segment more than once.
+func (l *RouteLeg) SegmentCongestion() map[RoadSegment]string {
+	segments := l.Annotation.Segments()
+	if len(segments) == 0 || len(l.Annotation.Congestion) != len(segments) {
+		return nil
+	}
+
+	congestion := make(map[RoadSegment]string, len(segments))
+	for i, s := range segments {
+		congestion[s] = l.Annotation.Congestion[i]
+	}
+	return congestion
+}
@@ -0,0 +1,30 @@
+package mapbox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRouteLeg_Segments(t *testing.T) {
+	resp := rawDirectionsResp{}
+	err := resp.UnmarshalJSON([]byte(`{"code":"Ok","routes":[{"legs":[{"annotation":{` +
+		`"congestion":["low","heavy"],"nodes":[5440513673,5440513674,9134097431]}}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	leg := resp.Routes[0].Legs[0]
+
+	want := []RoadSegment{{From: 5440513673, To: 5440513674}, {From: 5440513674, To: 9134097431}}
+	if got := leg.Annotation.Segments(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Segments() = %v, want %v", got, want)
+	}
+
+	wantCongestion := map[RoadSegment]string{want[0]: CongestionLow, want[1]: CongestionHeavy}
+	if got := leg.SegmentCongestion(); !reflect.DeepEqual(got, wantCongestion) {
+		t.Errorf("SegmentCongestion() = %v, want %v", got, wantCongestion)
+	}
+
+	if got := (&RouteLeg{}).SegmentCongestion(); got != nil {
+		t.Errorf("SegmentCongestion() of leg without annotation = %v", got)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !mapboxdebug
+// +build !mapboxdebug
+
+package mapbox
+
+// withStack returns err as is, build with mapboxdebug tag to get stack traces.
+func withStack(err error) error {
+	return err
+}
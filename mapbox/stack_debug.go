@@ -0,0 +1,51 @@
+//go:build mapboxdebug
+// +build mapboxdebug
+
+package mapbox
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+)
+
+const maxStackDepth = 32
+
+// stackError is an error with the stack trace of its creation, it is printed with %+v verb.
+type stackError struct {
+	err   error
+	stack []uintptr
+}
+
+// withStack attaches stack trace of the caller to err.
+func withStack(err error) error {
+	pcs := make([]uintptr, maxStackDepth)
+	// skip runtime.Callers, withStack and errorf or newStatusError
+	n := runtime.Callers(3, pcs)
+	return &stackError{err: err, stack: pcs[:n]}
+}
+
+func (e *stackError) Error() string {
+	return e.err.Error()
+}
+
+func (e *stackError) Unwrap() error {
+	return e.err
+}
+
+func (e *stackError) Format(s fmt.State, verb rune) {
+	io.WriteString(s, e.err.Error())
+	if verb != 'v' || !s.Flag('+') {
+		return
+	}
+
+	frames := runtime.CallersFrames(e.stack)
+	for {
+		f, more := frames.Next()
+		io.WriteString(s, "\n"+f.Function+"\n\t"+f.File+":"+strconv.Itoa(f.Line))
+		if !more {
+			return
+		}
+	}
+}
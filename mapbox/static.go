@@ -0,0 +1,503 @@
+package mapbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	attribution = "attribution"
+	logo        = "logo"
+	beforeLayer = "before_layer"
+	padding     = "padding"
+	falseStr    = "false"
+
+	defaultStyleUsername = "mapbox"
+
+	secretTokenPrefix = "sk."
+
+	// mapbox static images API limits
+	maxStaticImageSize     = 1280
+	maxStaticImageZoom     = 22
+	maxStaticImageBearing  = 360
+	maxStaticImagePitch    = 60
+	maxStaticImageOverlays = 100
+	maxStaticImageURLLen   = 8192
+
+	defaultRenderConcurrency = 4
+	defaultRenderMaxRetries  = 3
+	defaultRenderBackoff     = time.Second
+)
+
+var (
+	retinaSuffix = []byte("@2x")
+	autoViewport = []byte("auto")
+)
+
+// StaticImageRequest describes a static map image.
+// Exactly one of Center, Bbox or Auto should be set to define the viewport.
+type StaticImageRequest struct {
+	// Username of the style owner, default to mapbox.
+	Username string
+	// Style id, e.g. streets-v11.
+	StyleID string
+
+	// Overlay in mapbox static images overlay syntax, e.g. pin-s+555555(-87.0186,32.4055), see FormatOverlays.
+	// Multiple overlays should be comma-separated.
+	Overlay string
+
+	// Center of the image, used together with Zoom, Bearing and Pitch.
+	Center *GeoPoint
+	// Zoom level from 0 to 22.
+	Zoom float64
+	// Bearing rotates the map around its center, from 0 to 360.
+	Bearing float64
+	// Pitch tilts the map, from 0 to 60.
+	Pitch float64
+
+	// Bbox in minLon,minLat,maxLon,maxLat order.
+	Bbox []float64
+
+	// Auto fits viewport to the overlay.
+	Auto bool
+
+	// Width of the image in pixels, from 1 to 1280.
+	Width int
+	// Height of the image in pixels, from 1 to 1280.
+	Height int
+	// Retina renders image at @2x scale.
+	Retina bool
+
+	// Attribution controls whether there is attribution on the image, default true.
+	Attribution *bool
+	// Logo controls whether there is a Mapbox logo on the image, default true.
+	Logo *bool
+	// BeforeLayer is a style layer to insert the overlay below.
+	BeforeLayer string
+	// Padding around the overlay when Auto is used, in css order e.g. "10" or "10,20".
+	Padding string
+}
+
+// StaticImageResponse wraps rendered image.
+type StaticImageResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Image is the rendered png or jpeg image.
+	Image []byte
+}
+
+// RenderOptions tunes RenderStaticImages.
+type RenderOptions struct {
+	// Concurrency is a max number of in-flight requests, default 4.
+	Concurrency int
+	// MaxRetries is a max number of retries of a single image on 429 Too Many Requests, default 3,
+	// negative disables retries.
+	MaxRetries int
+	// Backoff is an initial delay between retries, doubled each retry, default 1s.
+	// X-Rate-Limit-Reset response header is preferred if present.
+	Backoff time.Duration
+}
+
+// RenderReport summarizes RenderStaticImages results per request index.
+type RenderReport struct {
+	Succeeded []int
+	Failed    map[int]error
+}
+
+// StaticImages builds mapbox static images API requests.
+type StaticImages interface {
+	// StaticImage renders image with the secret access token.
+	StaticImage(ctx context.Context, req *StaticImageRequest, opts ...CallOption) (*StaticImageResponse, error)
+	// RenderStaticImages renders many images with bounded concurrency and retries on rate limiting,
+	// every image is passed to out, e.g. to be written to a file.
+	RenderStaticImages(ctx context.Context, reqs []*StaticImageRequest, opts RenderOptions,
+		out func(i int, image []byte) error, callOpts ...CallOption) *RenderReport
+	// PublicStaticImageURL returns a ready to use image URL signed with the public access token,
+	// so it could be handed to browsers without leaking the secret token.
+	PublicStaticImageURL(ctx context.Context, req *StaticImageRequest) (string, error)
+	// EstimateURLLength returns length of the image URL without validating it against API limits,
+	// so batch jobs could pre-filter requests which would be rejected.
+	EstimateURLLength(req *StaticImageRequest) (int, error)
+	// RouteImageRequest builds a request of an image with a directions route drawn.
+	RouteImageRequest(route Route, base StaticImageRequest) (*StaticImageRequest, error)
+	// StaticTile returns a raster tile of a style with its cache headers.
+	StaticTile(ctx context.Context, req *StaticTileRequest, opts ...CallOption) (*StaticTileResponse, error)
+}
+
+// FastHttpStaticImages is a fasthttp StaticImages implementation
+type FastHttpStaticImages struct {
+	config
+
+	stylesAPIURL []byte
+
+	stringBufPull *stringsBufferPool
+}
+
+// PublicStaticImageURL builds styles/v1 static image URL with the public access token.
+// It fails if public access token is not set or looks like a secret one.
+func (c *FastHttpStaticImages) PublicStaticImageURL(ctx context.Context, req *StaticImageRequest) (string, error) {
+	if c.publicAccessToken == "" {
+		return "", errors.New("public access token is not set")
+	}
+	if strings.HasPrefix(c.publicAccessToken, secretTokenPrefix) {
+		return "", errors.New("public access token must not be a secret token")
+	}
+
+	if err := ValidateStaticImageRequest(req); err != nil {
+		return "", err
+	}
+
+	u, err := c.staticImageURL(req, c.publicAccessToken)
+	if err != nil {
+		return "", err
+	}
+	if err := checkStaticImageURLLen(len(u)); err != nil {
+		return "", err
+	}
+
+	c.withLogger(ctx, func(logger Logger) {
+		logger.Debugf("mapbox_sdk: public static image url for style %s/%s", req.Username, req.StyleID)
+	})
+
+	return u, nil
+}
+
+// EstimateURLLength returns length of the image URL signed with the longer of access and public tokens,
+// so a request which fits is accepted by both StaticImage and PublicStaticImageURL.
+func (c *FastHttpStaticImages) EstimateURLLength(req *StaticImageRequest) (int, error) {
+	token := c.accessToken
+	if len(c.publicAccessToken) > len(token) {
+		token = c.publicAccessToken
+	}
+
+	u, err := c.staticImageURL(req, token)
+	if err != nil {
+		return 0, err
+	}
+	return len(u), nil
+}
+
+func (c *FastHttpStaticImages) staticImageURL(req *StaticImageRequest, token string) (string, error) {
+	if c.err != nil {
+		return "", c.err
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	if err := c.writeStaticImagePath(buf, req); err != nil {
+		return "", err
+	}
+
+	buf.WriteString(questionMark + access_token + string(equalMark) + token)
+	encodeValues(buf, staticImageValues(req))
+
+	return buf.String(), nil
+}
+
+// StaticImage calls styles/v1 static image mapbox API thought fasthttp client.
+func (c *FastHttpStaticImages) StaticImage(ctx context.Context, req *StaticImageRequest, opts ...CallOption) (*StaticImageResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	resp, reqURI, err := c.staticImage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("render static image", reqURI, resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	return &StaticImageResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		Image:     resp.body,
+	}, nil
+}
+
+func (c *FastHttpStaticImages) staticImage(ctx context.Context, req *StaticImageRequest) (*rawResponse, string, error) {
+	if err := ValidateStaticImageRequest(req); err != nil {
+		return nil, "", err
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	if err := c.writeStaticImagePath(buf, req); err != nil {
+		return nil, "", err
+	}
+
+	values := staticImageValues(req)
+
+	buf.Write(c.accessTokenGetValue)
+	encodeValues(buf, values)
+
+	reqURI := buf.String()
+	if err := checkStaticImageURLLen(len(reqURI)); err != nil {
+		return nil, "", err
+	}
+
+	c.logRequest(ctx, "static image", buf.Bytes(), values, logKeyStyle, req.Username+slash+req.StyleID)
+
+	resp, err := c.do(ctx, "static image", getMethod, buf.Bytes(), nil)
+	if err != nil {
+		return nil, reqURI, err
+	}
+
+	c.withLogger(ctx, func(logger Logger) {
+		logger.Debugf("mapbox_sdk: static image response status=%d bytes=%d", resp.statusCode, len(resp.body))
+	})
+
+	return resp, reqURI, nil
+}
+
+// RenderStaticImages renders images with at most opts.Concurrency requests in flight.
+// Images rejected with 429 Too Many Requests are retried up to opts.MaxRetries times.
+// It never fails as a whole, every image error including out one is reported in RenderReport.Failed.
+// callOpts apply to every image request.
+func (c *FastHttpStaticImages) RenderStaticImages(ctx context.Context, reqs []*StaticImageRequest, opts RenderOptions,
+	out func(i int, image []byte) error, callOpts ...CallOption) *RenderReport {
+	ctx = withCallOptions(ctx, callOpts)
+
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultRenderConcurrency
+	}
+	switch {
+	case opts.MaxRetries == 0:
+		opts.MaxRetries = defaultRenderMaxRetries
+	case opts.MaxRetries < 0:
+		opts.MaxRetries = 0
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = defaultRenderBackoff
+	}
+
+	report := &RenderReport{Failed: make(map[int]error)}
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, opts.Concurrency)
+
+	for i := range reqs {
+		i := i
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			report.Failed[i] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := c.renderWithRetry(ctx, i, reqs[i], opts, out)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				report.Failed[i] = err
+				return
+			}
+			report.Succeeded = append(report.Succeeded, i)
+		}()
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+func (c *FastHttpStaticImages) renderWithRetry(ctx context.Context, i int, req *StaticImageRequest, opts RenderOptions,
+	out func(i int, image []byte) error) error {
+	var reqURI string
+	resp, err := c.retryRateLimited(ctx, opts.MaxRetries, opts.Backoff, func() (resp *rawResponse, err error) {
+		resp, reqURI, err = c.staticImage(ctx, req)
+		return resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.statusCode != http.StatusOK {
+		return newStatusError("render static image", reqURI, resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	return out(i, resp.body)
+}
+
+func checkStaticImageURLLen(n int) error {
+	if n > maxStaticImageURLLen {
+		return validationErrorf("", ConstraintMaxLength, "static image URL length %d exceeds %d", n, maxStaticImageURLLen)
+	}
+	return nil
+}
+
+// ValidateStaticImageRequest checks request against mapbox static images API limits:
+// image size, viewport ranges and number of overlays. URL length is checked when URL is built
+// by StaticImage, RenderStaticImages and PublicStaticImageURL.
+func ValidateStaticImageRequest(req *StaticImageRequest) error {
+	if req.StyleID == "" {
+		return validationErrorf("StyleID", ConstraintRequired, "style id is required")
+	}
+	if req.Width < 1 || req.Width > maxStaticImageSize {
+		return validationErrorf("Width", ConstraintRange, "invalid image size %dx%d, both sides must be from 1 to %d",
+			req.Width, req.Height, maxStaticImageSize)
+	}
+	if req.Height < 1 || req.Height > maxStaticImageSize {
+		return validationErrorf("Height", ConstraintRange, "invalid image size %dx%d, both sides must be from 1 to %d",
+			req.Width, req.Height, maxStaticImageSize)
+	}
+	if req.Center != nil {
+		if req.Zoom < 0 || req.Zoom > maxStaticImageZoom {
+			return validationErrorf("Zoom", ConstraintRange, "invalid zoom %v, must be from 0 to %d", req.Zoom, maxStaticImageZoom)
+		}
+		if req.Bearing < 0 || req.Bearing > maxStaticImageBearing {
+			return validationErrorf("Bearing", ConstraintRange, "invalid bearing %v, must be from 0 to %d", req.Bearing, maxStaticImageBearing)
+		}
+		if req.Pitch < 0 || req.Pitch > maxStaticImagePitch {
+			return validationErrorf("Pitch", ConstraintRange, "invalid pitch %v, must be from 0 to %d", req.Pitch, maxStaticImagePitch)
+		}
+	}
+	if req.Bbox != nil && len(req.Bbox) != 4 {
+		return validationErrorf("Bbox", ConstraintFormat, "invalid bbox %v, must be minLon,minLat,maxLon,maxLat", req.Bbox)
+	}
+	if n := countOverlays(req.Overlay); n > maxStaticImageOverlays {
+		return validationErrorf("Overlay", ConstraintMaxItems, "too many overlays %d, max is %d", n, maxStaticImageOverlays)
+	}
+
+	return nil
+}
+
+// countOverlays counts comma separated overlays skipping commas inside overlay arguments.
+func countOverlays(overlay string) int {
+	if overlay == "" {
+		return 0
+	}
+
+	n, depth := 1, 0
+	for i := 0; i < len(overlay); i++ {
+		switch overlay[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case comma:
+			if depth == 0 {
+				n++
+			}
+		}
+	}
+
+	return n
+}
+
+// writeStaticImagePath writes URL up to the query string.
+func (c *FastHttpStaticImages) writeStaticImagePath(buf *bytes.Buffer, req *StaticImageRequest) error {
+	if req.StyleID == "" {
+		return validationErrorf("StyleID", ConstraintRequired, "style id is required")
+	}
+	if req.Width <= 0 {
+		return validationErrorf("Width", ConstraintRange, "invalid image size %dx%d", req.Width, req.Height)
+	}
+	if req.Height <= 0 {
+		return validationErrorf("Height", ConstraintRange, "invalid image size %dx%d", req.Width, req.Height)
+	}
+
+	username := req.Username
+	if username == "" {
+		username = defaultStyleUsername
+	}
+
+	buf.Write(c.stylesAPIURL)
+	buf.WriteString(username)
+	buf.WriteString(slash)
+	buf.WriteString(req.StyleID)
+	buf.WriteString("/static/")
+	if req.Overlay != "" {
+		buf.WriteString(req.Overlay)
+		buf.WriteString(slash)
+	}
+
+	switch {
+	case req.Center != nil:
+		buf.WriteString(strconv.FormatFloat(req.Center.Lon, floatFormatNoExponent, 6, 64))
+		buf.WriteByte(comma)
+		buf.WriteString(strconv.FormatFloat(req.Center.Lat, floatFormatNoExponent, 6, 64))
+		buf.WriteByte(comma)
+		buf.WriteString(strconv.FormatFloat(req.Zoom, floatFormatNoExponent, -1, 64))
+		if req.Bearing != 0 || req.Pitch != 0 {
+			buf.WriteByte(comma)
+			buf.WriteString(strconv.FormatFloat(req.Bearing, floatFormatNoExponent, -1, 64))
+			buf.WriteByte(comma)
+			buf.WriteString(strconv.FormatFloat(req.Pitch, floatFormatNoExponent, -1, 64))
+		}
+	case len(req.Bbox) == 4:
+		buf.WriteByte('[')
+		for i, v := range req.Bbox {
+			if i > 0 {
+				buf.WriteByte(comma)
+			}
+			buf.WriteString(strconv.FormatFloat(v, floatFormatNoExponent, 6, 64))
+		}
+		buf.WriteByte(']')
+	case req.Auto:
+		if req.Overlay == "" {
+			return validationErrorf("Overlay", ConstraintDepends, "auto viewport requires an overlay")
+		}
+		buf.Write(autoViewport)
+	default:
+		return validationErrorf("Center", ConstraintRequired, "one of center, bbox or auto viewport is required")
+	}
+
+	buf.WriteString(slash)
+	buf.WriteString(strconv.Itoa(req.Width))
+	buf.WriteByte('x')
+	buf.WriteString(strconv.Itoa(req.Height))
+	if req.Retina {
+		buf.Write(retinaSuffix)
+	}
+
+	return nil
+}
+
+func staticImageValues(req *StaticImageRequest) map[string]string {
+	values := make(map[string]string, 4)
+
+	if req.Attribution != nil && !*req.Attribution {
+		values[attribution] = falseStr
+	}
+	if req.Logo != nil && !*req.Logo {
+		values[logo] = falseStr
+	}
+	if req.BeforeLayer != "" {
+		values[beforeLayer] = req.BeforeLayer
+	}
+	if req.Padding != "" && req.Auto {
+		values[padding] = req.Padding
+	}
+
+	return values
+}
+
+func NewFastHttpStaticImages(opts ...Option) *FastHttpStaticImages {
+	c := FastHttpStaticImages{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.stylesAPIURL = c.apiURL("/styles/v1/")
+
+	return &c
+}
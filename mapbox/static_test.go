@@ -0,0 +1,205 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpStaticImages_PublicStaticImageURL(t *testing.T) {
+	noLogo := false
+	tests := []struct {
+		name    string
+		token   string
+		req     *StaticImageRequest
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "center",
+			token: "pk.public",
+			req: &StaticImageRequest{
+				StyleID: "streets-v11",
+				Center:  &GeoPoint{Lon: -122.4241, Lat: 37.78},
+				Zoom:    14.25,
+				Width:   600,
+				Height:  400,
+				Retina:  true,
+				Logo:    &noLogo,
+			},
+			want: "https://api.mapbox.com/styles/v1/mapbox/streets-v11/static/-122.424100,37.780000,14.25/600x400@2x?access_token=pk.public&logo=false",
+		},
+		{
+			name:  "auto with overlay",
+			token: "pk.public",
+			req: &StaticImageRequest{
+				Username: "user",
+				StyleID:  "style",
+				Overlay:  "pin-s+555555(-87.0186,32.4055)",
+				Auto:     true,
+				Width:    300,
+				Height:   200,
+			},
+			want: "https://api.mapbox.com/styles/v1/user/style/static/pin-s+555555(-87.0186,32.4055)/auto/300x200?access_token=pk.public",
+		},
+		{
+			name:    "secret token",
+			token:   "sk.secret",
+			req:     &StaticImageRequest{StyleID: "streets-v11", Auto: true, Overlay: "a", Width: 1, Height: 1},
+			wantErr: true,
+		},
+		{
+			name:    "no viewport",
+			token:   "pk.public",
+			req:     &StaticImageRequest{StyleID: "streets-v11", Width: 1, Height: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewFastHttpStaticImages(AccessToken("sk.secret"), PublicAccessToken(tt.token))
+			got, err := c.PublicStaticImageURL(context.Background(), tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PublicStaticImageURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PublicStaticImageURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateStaticImageRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     StaticImageRequest
+		wantErr bool
+	}{
+		{name: "valid", req: StaticImageRequest{StyleID: "s", Center: &GeoPoint{}, Zoom: 22, Bearing: 360, Pitch: 60, Width: 1280, Height: 1}},
+		{name: "no style", req: StaticImageRequest{Width: 1, Height: 1}, wantErr: true},
+		{name: "too wide", req: StaticImageRequest{StyleID: "s", Width: 1281, Height: 1}, wantErr: true},
+		{name: "zoom", req: StaticImageRequest{StyleID: "s", Center: &GeoPoint{}, Zoom: 23, Width: 1, Height: 1}, wantErr: true},
+		{name: "pitch", req: StaticImageRequest{StyleID: "s", Center: &GeoPoint{}, Pitch: 61, Width: 1, Height: 1}, wantErr: true},
+		{name: "bbox", req: StaticImageRequest{StyleID: "s", Bbox: []float64{1, 2}, Width: 1, Height: 1}, wantErr: true},
+		{
+			name: "overlay args are not counted",
+			req:  StaticImageRequest{StyleID: "s", Overlay: "pin-s(1,2),path-5(a,b,c),geojson({\"a\":[1,2]})", Width: 1, Height: 1},
+		},
+		{
+			name:    "too many overlays",
+			req:     StaticImageRequest{StyleID: "s", Overlay: strings.Repeat("pin-s(1,2),", 100) + "pin-s(1,2)", Width: 1, Height: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateStaticImageRequest(&tt.req); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateStaticImageRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFastHttpStaticImages_EstimateURLLength(t *testing.T) {
+	c := NewFastHttpStaticImages(PublicAccessToken("pk.public"))
+	req := &StaticImageRequest{StyleID: "s", Overlay: strings.Repeat("a", 9000), Auto: true, Width: 1, Height: 1}
+
+	n, err := c.EstimateURLLength(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n <= 9000 {
+		t.Errorf("EstimateURLLength() = %d", n)
+	}
+
+	if _, err := c.PublicStaticImageURL(context.Background(), req); err == nil {
+		t.Error("URL length error expected")
+	}
+
+	secret := NewFastHttpStaticImages(PublicAccessToken("pk.public"), AccessToken("sk."+strings.Repeat("s", 100)))
+	if m, _ := secret.EstimateURLLength(req); m != n+len("sk.")+100-len("pk.public") {
+		t.Errorf("EstimateURLLength() = %d, want it with the longer secret token", m)
+	}
+}
+
+func TestFastHttpStaticImages_StaticImageURLLength(t *testing.T) {
+	c := NewFastHttpStaticImages(AccessToken("sk.secret"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			t.Error("unexpected request")
+			return nil
+		})))
+	req := &StaticImageRequest{StyleID: "s", Overlay: strings.Repeat("a", 9000), Auto: true, Width: 1, Height: 1}
+
+	_, err := c.StaticImage(context.Background(), req)
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Constraint != ConstraintMaxLength {
+		t.Errorf("StaticImage() error = %v, want URL length ValidationError", err)
+	}
+}
+
+func TestFastHttpStaticImages_RenderStaticImages(t *testing.T) {
+	mu := sync.Mutex{}
+	attempts := map[string]int{}
+
+	c := NewFastHttpStaticImages(AccessToken("sk.secret"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			style := strings.Split(string(req.RequestURI()), "/")[6]
+
+			mu.Lock()
+			attempts[style]++
+			attempt := attempts[style]
+			mu.Unlock()
+
+			switch {
+			case style == "broken":
+				resp.SetStatusCode(fasthttp.StatusUnprocessableEntity)
+			case style == "throttled" && attempt == 1:
+				resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+			default:
+				resp.SetBodyString("png:" + style)
+			}
+			return nil
+		})))
+
+	reqs := []*StaticImageRequest{
+		{StyleID: "a", Center: &GeoPoint{}, Width: 64, Height: 64},
+		{StyleID: "throttled", Center: &GeoPoint{}, Width: 64, Height: 64},
+		{StyleID: "broken", Center: &GeoPoint{}, Width: 64, Height: 64},
+		{StyleID: "invalid", Width: 64, Height: 64},
+	}
+
+	images := make([]string, len(reqs))
+	report := c.RenderStaticImages(context.Background(), reqs, RenderOptions{Concurrency: 2, Backoff: time.Millisecond},
+		func(i int, image []byte) error {
+			images[i] = string(image)
+			return nil
+		})
+
+	sort.Ints(report.Succeeded)
+	if !reflect.DeepEqual(report.Succeeded, []int{0, 1}) {
+		t.Errorf("unexpected succeeded %v", report.Succeeded)
+	}
+	if len(report.Failed) != 2 || report.Failed[2] == nil || report.Failed[3] == nil {
+		t.Errorf("unexpected failed %v", report.Failed)
+	}
+	if images[0] != "png:a" || images[1] != "png:throttled" {
+		t.Errorf("unexpected images %v", images)
+	}
+	if attempts["throttled"] != 2 || attempts["invalid"] != 0 {
+		t.Errorf("unexpected attempts %v", attempts)
+	}
+
+	attempts = map[string]int{}
+	report = c.RenderStaticImages(context.Background(), reqs[1:2], RenderOptions{MaxRetries: -1},
+		func(i int, image []byte) error { return nil }, WithTimeout(time.Second))
+	if len(report.Failed) != 1 || attempts["throttled"] != 1 {
+		t.Errorf("retries must be disabled, failed %v, attempts %v", report.Failed, attempts)
+	}
+}
@@ -0,0 +1,148 @@
+package mapbox
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// maxTileZoom is the max zoom of static and vector tiles.
+const maxTileZoom = 22
+
+// Static tile sizes.
+const (
+	TileSize256 = 256
+	TileSize512 = 512
+)
+
+// StaticTileRequest describes a raster tile of a style.
+type StaticTileRequest struct {
+	// Username of the style owner, default to mapbox.
+	Username string
+	// Style id, e.g. streets-v11.
+	StyleID string
+
+	// Z is a zoom level from 0 to 22, X and Y are tile coordinates from 0 to 2^Z-1.
+	Z, X, Y int
+
+	// TileSize is TileSize256 or TileSize512, mapbox default is 512.
+	TileSize int
+	// Retina renders tile at @2x scale.
+	Retina bool
+}
+
+// CacheHeaders are HTTP caching response headers, e.g. to be passed through by a tile proxy.
+type CacheHeaders struct {
+	CacheControl string
+	Expires      string
+	LastModified string
+	ETag         string
+}
+
+// StaticTileResponse wraps raster tile.
+type StaticTileResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Cache headers of the tile.
+	Cache CacheHeaders
+	// ContentType is the tile image type, e.g. image/png.
+	ContentType string
+	// Image is the tile image.
+	Image []byte
+}
+
+// StaticTile calls styles/v1 static tiles mapbox API thought fasthttp client.
+func (c *FastHttpStaticImages) StaticTile(ctx context.Context, req *StaticTileRequest, opts ...CallOption) (*StaticTileResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := validateStaticTileRequest(req); err != nil {
+		return nil, err
+	}
+
+	username := req.Username
+	if username == "" {
+		username = defaultStyleUsername
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	buf.Write(c.stylesAPIURL)
+	buf.WriteString(username)
+	buf.WriteString(slash)
+	buf.WriteString(req.StyleID)
+	buf.WriteString("/tiles/")
+	if req.TileSize != 0 {
+		buf.WriteString(strconv.Itoa(req.TileSize))
+		buf.WriteString(slash)
+	}
+	writeTilePath(buf, req.Z, req.X, req.Y)
+	if req.Retina {
+		buf.Write(retinaSuffix)
+	}
+	buf.Write(c.accessTokenGetValue)
+
+	reqURI := buf.String()
+
+	c.logRequest(ctx, "static tile", buf.Bytes(), nil, logKeyStyle, username+slash+req.StyleID)
+
+	resp, err := c.do(ctx, "static tile", getMethod, buf.Bytes(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.withLogger(ctx, func(logger Logger) {
+		logger.Debugf("mapbox_sdk: static tile response status=%d bytes=%d", resp.statusCode, len(resp.body))
+	})
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("get static tile", reqURI, resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	return &StaticTileResponse{
+		RateLimit:   resp.rateLimit,
+		Meta:        resp.meta,
+		Cache:       resp.cache,
+		ContentType: resp.contentType,
+		Image:       resp.body,
+	}, nil
+}
+
+func validateStaticTileRequest(req *StaticTileRequest) error {
+	if req.StyleID == "" {
+		return validationErrorf("StyleID", ConstraintRequired, "style id is required")
+	}
+	if err := validateTile(req.Z, req.X, req.Y); err != nil {
+		return err
+	}
+	if req.TileSize != 0 && req.TileSize != TileSize256 && req.TileSize != TileSize512 {
+		return validationErrorf("TileSize", ConstraintEnum, "invalid tile size %d", req.TileSize)
+	}
+	return nil
+}
+
+// validateTile checks zoom and that x and y are within 0 to 2^z-1.
+func validateTile(z, x, y int) error {
+	if z < 0 || z > maxTileZoom {
+		return validationErrorf("Z", ConstraintRange, "invalid zoom %d, must be from 0 to %d", z, maxTileZoom)
+	}
+	n := 1 << uint(z)
+	if x < 0 || x >= n {
+		return validationErrorf("X", ConstraintRange, "invalid tile x %d at zoom %d", x, z)
+	}
+	if y < 0 || y >= n {
+		return validationErrorf("Y", ConstraintRange, "invalid tile y %d at zoom %d", y, z)
+	}
+	return nil
+}
+
+// writeTilePath writes z/x/y tile path.
+func writeTilePath(buf *bytes.Buffer, z, x, y int) {
+	buf.WriteString(strconv.Itoa(z))
+	buf.WriteString(slash)
+	buf.WriteString(strconv.Itoa(x))
+	buf.WriteString(slash)
+	buf.WriteString(strconv.Itoa(y))
+}
@@ -0,0 +1,47 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpStaticImages_StaticTile(t *testing.T) {
+	var gotURI string
+	c := NewFastHttpStaticImages(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			gotURI = string(req.RequestURI())
+			resp.Header.Set("Cache-Control", "max-age=43200")
+			resp.Header.Set("ETag", `"abc"`)
+			resp.Header.Set("Last-Modified", "Mon, 12 Oct 2026 10:00:00 GMT")
+			resp.Header.SetContentType("image/png")
+			resp.SetBodyString("png")
+			return nil
+		})))
+
+	resp, err := c.StaticTile(context.Background(), &StaticTileRequest{StyleID: "streets-v11", Z: 3, X: 7, Y: 2, TileSize: TileSize256, Retina: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://api.mapbox.com/styles/v1/mapbox/streets-v11/tiles/256/3/7/2@2x?access_token=token"; gotURI != want {
+		t.Errorf("URI = %s, want %s", gotURI, want)
+	}
+	want := CacheHeaders{CacheControl: "max-age=43200", ETag: `"abc"`, LastModified: "Mon, 12 Oct 2026 10:00:00 GMT"}
+	if resp.Cache != want || resp.ContentType != "image/png" || string(resp.Image) != "png" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+
+	invalid := []*StaticTileRequest{
+		{Z: 1},
+		{StyleID: "streets-v11", Z: 23},
+		{StyleID: "streets-v11", Z: 3, X: 8},
+		{StyleID: "streets-v11", Z: 0, Y: 1},
+		{StyleID: "streets-v11", TileSize: 128},
+	}
+	for _, req := range invalid {
+		if _, err := c.StaticTile(context.Background(), req); err == nil {
+			t.Errorf("StaticTile(%+v) error expected", req)
+		}
+	}
+}
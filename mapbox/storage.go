@@ -0,0 +1,75 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ErrNotRetainable is returned by Retain of temporary geocoding results in strict storage mode.
+var ErrNotRetainable = errors.New("temporary geocoding results must not be stored")
+
+// Permanent requests geocoding results which could be stored, it requires permanent geocoding access.
+// v6 requests get permanent=true unless overridden per request, v5 requests are sent to PlacesPermanent endpoint.
+// default to false.
+func Permanent(permanent bool) Option {
+	return func(c config) config {
+		c.permanent = permanent
+		return c
+	}
+}
+
+// StrictStorage makes Retain fail with ErrNotRetainable for temporary results,
+// so they couldn't be persisted by mistake. default to false.
+func StrictStorage(strict bool) Option {
+	return func(c config) config {
+		c.strictStorage = strict
+		return c
+	}
+}
+
+// requestPermanent returns request override if set, client default otherwise.
+func (c *config) requestPermanent(override *bool) bool {
+	if override != nil {
+		return *override
+	}
+	return c.permanent
+}
+
+// PermanentFallback retries v5 geocode calls rejected by PlacesPermanent endpoint with 403 Forbidden,
+// e.g. if the account has no permanent geocoding access, with PlacesTemporary endpoint.
+// Such responses are not Permanent, so pipelines could proceed without storing them. default to false.
+func PermanentFallback(fallback bool) Option {
+	return func(c config) config {
+		c.permanentFallback = fallback
+		return c
+	}
+}
+
+// retain guards raw response storage, responses built without config are not guarded.
+func (c *config) retain(permanent bool, raw []byte) ([]byte, error) {
+	if !permanent && c != nil && c.strictStorage {
+		return nil, ErrNotRetainable
+	}
+	return raw, nil
+}
+
+// doGeocode calls v5 geocode API and falls back to temporary endpoint if PermanentFallback is set,
+// permanent is false if the response is got from temporary endpoint.
+func (c *FastHttpGeocoder) doGeocode(ctx context.Context, op string, reqURI []byte) (resp *rawResponse, permanent bool, err error) {
+	permanent = c.geocodeEndpoint == PlacesPermanent
+
+	resp, err = c.do(ctx, op, getMethod, reqURI, nil)
+	if err != nil || !permanent || !c.permanentFallback || resp.statusCode != http.StatusForbidden {
+		return resp, permanent, err
+	}
+
+	c.withLogger(ctx, func(logger Logger) {
+		logger.Errorf("mapbox_sdk: %s is forbidden by permanent endpoint, falling back to temporary one", op)
+	})
+
+	tempURI := append(append([]byte(nil), c.temporaryAPIURL...), reqURI[len(c.geocodeAPIURL):]...)
+	resp, err = c.do(ctx, op, getMethod, tempURI, nil)
+
+	return resp, false, err
+}
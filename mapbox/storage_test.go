@@ -0,0 +1,142 @@
+package mapbox
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestPermanent(t *testing.T) {
+	off := false
+	tests := []struct {
+		name    string
+		opts    []Option
+		req     ForwardGeocodeV6Request
+		wantURI string
+		wantErr error
+	}{
+		{name: "temporary", req: ForwardGeocodeV6Request{Query: "a"}, wantURI: "q=a"},
+		{name: "temporary strict", opts: []Option{StrictStorage(true)}, req: ForwardGeocodeV6Request{Query: "a"}, wantURI: "q=a", wantErr: ErrNotRetainable},
+		{name: "permanent", opts: []Option{Permanent(true), StrictStorage(true)}, req: ForwardGeocodeV6Request{Query: "a"}, wantURI: "q=a&permanent=true"},
+		{
+			name:    "request override",
+			opts:    []Option{Permanent(true), StrictStorage(true)},
+			req:     ForwardGeocodeV6Request{Query: "a", Permanent: &off},
+			wantURI: "q=a", wantErr: ErrNotRetainable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uri string
+			g := NewFastHttpGeocoderV6(append(tt.opts, AccessToken("token"), HttpClient(fastHttpClientFunc(
+				func(req *fasthttp.Request, resp *fasthttp.Response) error {
+					uri = string(req.RequestURI())
+					resp.SetBodyString(testV6RespBody)
+					return nil
+				})))...)
+
+			resp, err := g.ForwardGeocodeV6(context.Background(), &tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasSuffix(uri, tt.wantURI) {
+				t.Errorf("uri = %s, want suffix %s", uri, tt.wantURI)
+			}
+
+			raw, err := resp.Retain()
+			if err != tt.wantErr {
+				t.Fatalf("Retain() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && string(raw) != testV6RespBody {
+				t.Errorf("unexpected raw response %s", raw)
+			}
+		})
+	}
+}
+
+func TestPermanent_GeocodeEndpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{name: "default", want: "mapbox.places"},
+		{name: "permanent", opts: []Option{Permanent(true)}, want: "mapbox.places-permanent"},
+		{name: "explicit permanent", opts: []Option{GeocodeEndpoint(PlacesPermanent)}, want: "mapbox.places-permanent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := build(tt.opts).geocodeEndpoint; string(got) != tt.want {
+				t.Errorf("geocodeEndpoint = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermanentFallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		wantURIs []string
+		wantErr  bool
+	}{
+		{
+			name:     "no fallback",
+			opts:     []Option{Permanent(true)},
+			wantURIs: []string{"/geocoding/v5/mapbox.places-permanent/1.000000,1.000000.json"},
+			wantErr:  true,
+		},
+		{
+			name: "fallback",
+			opts: []Option{Permanent(true), PermanentFallback(true)},
+			wantURIs: []string{
+				"/geocoding/v5/mapbox.places-permanent/1.000000,1.000000.json",
+				"/geocoding/v5/mapbox.places/1.000000,1.000000.json",
+			},
+		},
+		{
+			name:     "temporary endpoint",
+			opts:     []Option{PermanentFallback(true)},
+			wantURIs: []string{"/geocoding/v5/mapbox.places/1.000000,1.000000.json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uris []string
+			g := NewFastHttpGeocoder(append(tt.opts, AccessToken("token"), StrictStorage(true), HttpClient(fastHttpClientFunc(
+				func(req *fasthttp.Request, resp *fasthttp.Response) error {
+					uri := string(req.URI().Path())
+					uris = append(uris, uri)
+					if strings.Contains(uri, "permanent") {
+						resp.SetStatusCode(fasthttp.StatusForbidden)
+						return nil
+					}
+					resp.SetBody(testRespBody)
+					return nil
+				})))...)
+
+			resp, err := g.ReverseGeocode(context.Background(), &ReverseGeocodeRequest{GeoPoint: GeoPoint{Lon: 1, Lat: 1}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(uris, tt.wantURIs) {
+				t.Errorf("uris = %v, want %v", uris, tt.wantURIs)
+			}
+			if err != nil {
+				return
+			}
+			if resp.Permanent {
+				t.Error("temporary response expected")
+			}
+			if _, err := resp.Retain(); err != ErrNotRetainable {
+				t.Errorf("Retain() err = %v, want ErrNotRetainable", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package mapbox
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	draft = "draft"
+	fresh = "fresh"
+
+	// StyleVisibilityPublic is a style visible to anyone with a public token.
+	StyleVisibilityPublic = "public"
+	// StyleVisibilityPrivate is a style visible only to the owner.
+	StyleVisibilityPrivate = "private"
+)
+
+// ListStylesRequest describes list styles request.
+type ListStylesRequest struct {
+	// Username of the styles owner.
+	Username string
+	// Draft lists draft versions of the styles instead of the published ones.
+	Draft bool
+	// Fresh bypasses mapbox cache to get the latest modifications.
+	Fresh bool
+}
+
+// StyleMetadata describes a style without its layers and sources.
+type StyleMetadata struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Owner      string    `json:"owner"`
+	Version    int       `json:"version"`
+	Visibility string    `json:"visibility"`
+	Protected  bool      `json:"protected"`
+	Created    time.Time `json:"created"`
+	Modified   time.Time `json:"modified"`
+}
+
+// easyjson:json
+type rawListStylesResp []StyleMetadata
+
+// ListStylesResponse wraps styles metadata list.
+type ListStylesResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+	// Decoded is set instead of the fields below if CustomDecoder is registered for the endpoint
+	Decoded interface{}
+
+	Styles []StyleMetadata
+}
+
+// Styles covers mapbox styles API.
+type Styles interface {
+	// ListStyles calls styles/v1 list mapbox API
+	ListStyles(ctx context.Context, req *ListStylesRequest, opts ...CallOption) (*ListStylesResponse, error)
+}
+
+// FastHttpStyles is a fasthttp Styles implementation
+type FastHttpStyles struct {
+	config
+
+	stylesAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// ListStyles calls styles/v1 list mapbox API thought fasthttp client.
+func (c *FastHttpStyles) ListStyles(ctx context.Context, req *ListStylesRequest, opts ...CallOption) (*ListStylesResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if req.Username == "" {
+		return nil, validationErrorf("Username", ConstraintRequired, "username is required")
+	}
+
+	values := make(map[string]string, 2)
+	if req.Draft {
+		values[draft] = trueStr
+	}
+	if req.Fresh {
+		values[fresh] = trueStr
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.stylesAPIURL.Write(buf, values, req.Username)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "list styles", reqURI, values, logKeyUsername, req.Username)
+
+	resp, err := c.do(ctx, "list styles", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "list styles", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("list styles", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	if decoded, ok, err := c.customDecode(EndpointListStyles, resp.body); ok {
+		if err != nil {
+			return nil, err
+		}
+		return &ListStylesResponse{
+			RateLimit: resp.rateLimit,
+			Meta:      resp.meta,
+			RawResp:   resp.body,
+			Decoded:   decoded,
+		}, nil
+	}
+
+	respRaw := rawListStylesResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall raw list styles resp %s: %w", string(resp.body), err)
+	}
+
+	return &ListStylesResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Styles:    respRaw,
+	}, nil
+}
+
+// ModifiedSince returns styles modified after t, e.g. the last publish time known to CI.
+func (r *ListStylesResponse) ModifiedSince(t time.Time) []StyleMetadata {
+	var styles []StyleMetadata
+	for _, s := range r.Styles {
+		if s.Modified.After(t) {
+			styles = append(styles, s)
+		}
+	}
+	return styles
+}
+
+func NewFastHttpStyles(opts ...Option) *FastHttpStyles {
+	c := FastHttpStyles{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.stylesAPIURL = c.endpointURL("/styles/v1/")
+
+	return &c
+}
@@ -0,0 +1,68 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+const testListStylesRespBody = `[
+{"id":"old","name":"Old","owner":"user","version":8,"visibility":"private","protected":false,
+"created":"2020-01-01T10:00:00.000Z","modified":"2020-01-02T10:00:00.000Z"},
+{"id":"new","name":"New","owner":"user","version":8,"visibility":"public","protected":true,
+"created":"2020-01-01T10:00:00.000Z","modified":"2020-03-01T10:00:00.000Z"}]`
+
+func TestFastHttpStyles_ListStyles(t *testing.T) {
+	var uri string
+	c := NewFastHttpStyles(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri = string(req.RequestURI())
+			resp.SetBodyString(testListStylesRespBody)
+			return nil
+		})))
+
+	r, err := c.ListStyles(context.Background(), &ListStylesRequest{Username: "user", Draft: true, Fresh: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(uri, "/styles/v1/user?") || !strings.Contains(uri, "draft=true") || !strings.Contains(uri, "fresh=true") {
+		t.Errorf("unexpected uri %s", uri)
+	}
+
+	if len(r.Styles) != 2 {
+		t.Fatalf("unexpected styles %+v", r.Styles)
+	}
+	s := r.Styles[1]
+	switch {
+	case s.ID != "new" || s.Visibility != StyleVisibilityPublic || !s.Protected:
+		t.Errorf("unexpected style %+v", s)
+	case !s.Created.Equal(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)):
+		t.Errorf("unexpected created %v", s.Created)
+	case !s.Modified.Equal(time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC)):
+		t.Errorf("unexpected modified %v", s.Modified)
+	}
+
+	modified := r.ModifiedSince(time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC))
+	if len(modified) != 1 || modified[0].ID != "new" {
+		t.Errorf("ModifiedSince() = %+v", modified)
+	}
+}
+
+func TestFastHttpStyles_ListStylesUsername(t *testing.T) {
+	c := NewFastHttpStyles(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			t.Error("unexpected request")
+			return nil
+		})))
+
+	_, err := c.ListStyles(context.Background(), &ListStylesRequest{Draft: true})
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Field != "Username" || verr.Constraint != ConstraintRequired {
+		t.Errorf("ListStyles() error = %v, want Username ValidationError", err)
+	}
+}
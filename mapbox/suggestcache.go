@@ -0,0 +1,106 @@
+package mapbox
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// suggestCellDegrees is a proximity grid cell size of suggest cache keys, about 1km.
+const suggestCellDegrees = 0.01
+
+// SuggestCache caches up to size suggest responses for ttl, so keystrokes of users typing the same prefix
+// in the same area are not sent to mapbox again. Keys are made of query prefix, proximity rounded to about 1km grid
+// cell, language, types, country and limit, session token is not a part of the key.
+// Cached responses have zero Meta.Attempts, WithNoCache call option skips cached ones.
+// Keep ttl short, e.g. a minute, suggestions depend on fresh POI data.
+// default to no cache.
+func SuggestCache(ttl time.Duration, size int) Option {
+	return func(c config) config {
+		c.suggestTTL = ttl
+		c.suggestSize = size
+		return c
+	}
+}
+
+type suggestCacheEntry struct {
+	resp    *SuggestResponse
+	expires time.Time
+}
+
+// suggestCache is a fixed size cache evicting the oldest entries, it is shared by config copies.
+type suggestCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]suggestCacheEntry
+	// keys is a ring of entry keys in insertion order.
+	keys []string
+	next int
+}
+
+func newSuggestCache(ttl time.Duration, size int) *suggestCache {
+	return &suggestCache{
+		ttl:     ttl,
+		entries: make(map[string]suggestCacheEntry, size),
+		keys:    make([]string, size),
+	}
+}
+
+// get returns a copy of unexpired cached response.
+func (c *suggestCache) get(key string, now time.Time) (*SuggestResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !now.Before(e.expires) {
+		return nil, false
+	}
+
+	resp := *e.resp
+	resp.Suggestions = append([]Suggestion(nil), e.resp.Suggestions...)
+	resp.Meta = Meta{Endpoint: e.resp.Meta.Endpoint, RequestID: e.resp.Meta.RequestID}
+	return &resp, true
+}
+
+// put caches a copy of resp replacing the oldest entry if cache is full,
+// so callers changing returned suggestions do not change cached ones.
+func (c *suggestCache) put(key string, resp *SuggestResponse, now time.Time) {
+	cached := *resp
+	cached.Suggestions = append([]Suggestion(nil), resp.Suggestions...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		if old := c.keys[c.next]; old != "" {
+			delete(c.entries, old)
+		}
+		c.keys[c.next] = key
+		c.next = (c.next + 1) % len(c.keys)
+	}
+	c.entries[key] = suggestCacheEntry{resp: &cached, expires: now.Add(c.ttl)}
+}
+
+// suggestCacheKey identifies suggestions of a query prefix in a proximity grid cell.
+func suggestCacheKey(req *SuggestRequest, language string) string {
+	b := strings.Builder{}
+	b.WriteString(strings.ToLower(strings.TrimSpace(req.Query)))
+	b.WriteByte(0)
+	if req.Proximity != nil {
+		b.WriteString(strconv.FormatFloat(math.Round(req.Proximity.Lon/suggestCellDegrees), 'f', 0, 64))
+		b.WriteByte(comma)
+		b.WriteString(strconv.FormatFloat(math.Round(req.Proximity.Lat/suggestCellDegrees), 'f', 0, 64))
+	}
+	b.WriteByte(0)
+	b.WriteString(language)
+	b.WriteByte(0)
+	b.WriteString(strings.Join(req.Types, ","))
+	b.WriteByte(0)
+	b.WriteString(req.Country)
+	b.WriteByte(0)
+	b.WriteString(strconv.Itoa(req.Limit))
+	return b.String()
+}
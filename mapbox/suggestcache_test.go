@@ -0,0 +1,53 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestSuggestCache(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	calls := 0
+	s := NewFastHttpSearchBox(AccessToken("token"), WithClock(clock), SuggestCache(time.Minute, 2), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			calls++
+			resp.SetBodyString(testSuggestRespBody)
+			return nil
+		})))
+
+	suggest := func(query, session string, proximity GeoPoint, opts ...CallOption) *SuggestResponse {
+		t.Helper()
+		resp, err := s.Suggest(context.Background(), &SuggestRequest{Query: query, SessionToken: session, Proximity: &proximity}, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	first := suggest("Linc", "a", GeoPoint{Lon: -77.031, Lat: 38.9})
+	name := first.Suggestions[0].Name
+	first.Suggestions[0].Name = "changed by the first caller"
+	// another user nearby types the same prefix
+	cached := suggest("linc", "b", GeoPoint{Lon: -77.032, Lat: 38.901})
+	if calls != 1 || cached.Meta.Attempts != 0 || first.Meta.Attempts != 1 || len(cached.Suggestions) != 1 {
+		t.Errorf("calls = %d, attempts %d and %d, want a cached response", calls, first.Meta.Attempts, cached.Meta.Attempts)
+	}
+	if cached.Suggestions[0].Name != name {
+		t.Errorf("cached suggestion is changed: %s", cached.Suggestions[0].Name)
+	}
+
+	suggest("linc", "c", GeoPoint{Lon: -76, Lat: 38.9})
+	suggest("linc", "d", GeoPoint{Lon: -77.031, Lat: 38.9}, WithNoCache())
+	if calls != 3 {
+		t.Errorf("calls = %d, other cell and no cache calls must not be cached", calls)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	suggest("linc", "e", GeoPoint{Lon: -77.031, Lat: 38.9})
+	if calls != 4 {
+		t.Errorf("calls = %d, expired response must not be used", calls)
+	}
+}
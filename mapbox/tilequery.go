@@ -0,0 +1,204 @@
+package mapbox
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	radius   = "radius"
+	layers   = "layers"
+	dedupe   = "dedupe"
+	geometry = "geometry"
+
+	tilequeryProperty = "tilequery"
+
+	maxTilequeryLimit = 50
+)
+
+// Tilequery geometry types.
+const (
+	TilequeryGeometryPolygon    = "polygon"
+	TilequeryGeometryLinestring = "linestring"
+	TilequeryGeometryPoint      = "point"
+)
+
+// TilequeryRequest describes tilequery request.
+type TilequeryRequest struct {
+	// TilesetIDs to query, e.g. mapbox.mapbox-streets-v8.
+	TilesetIDs []string
+	GeoPoint   GeoPoint
+	// Radius in meters to search features within, default 0 returns only features containing the point.
+	Radius float64
+	// Limit is the maximum number of results, mapbox default is 5 and max is 50.
+	Limit int
+	// Layers limits results to the tileset layers, e.g. landuse.
+	Layers []string
+	// Dedupe removes duplicate results of features split across tiles, mapbox default is true.
+	Dedupe *bool
+	// Geometry limits results to a geometry type, e.g. TilequeryGeometryPolygon.
+	Geometry string
+}
+
+// TilequeryFeature is a feature found by tilequery.
+type TilequeryFeature struct {
+	Type string `json:"type"`
+	// Geometry is the point of the feature closest to the query point.
+	Geometry Geometry `json:"geometry"`
+	// Properties are the feature attributes, e.g. class of a landuse feature.
+	Properties map[string]interface{} `json:"properties"`
+	// Tilequery is set from tilequery property, it is removed from Properties.
+	Tilequery TilequeryInfo `json:"-"`
+}
+
+// TilequeryInfo describes how a feature matched the query.
+type TilequeryInfo struct {
+	// Distance in meters from the query point, 0 if the point is within the feature.
+	Distance float64
+	// Geometry is the original feature geometry type, e.g. TilequeryGeometryPolygon.
+	Geometry string
+	// Layer is the tileset layer of the feature.
+	Layer string
+}
+
+// easyjson:json
+type rawTilequeryResp struct {
+	Features []TilequeryFeature `json:"features"`
+}
+
+// TilequeryResponse wraps tilequery features.
+type TilequeryResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	// Features are sorted by distance.
+	Features []TilequeryFeature
+}
+
+// Tilequery covers mapbox tilequery API.
+type Tilequery interface {
+	// Tilequery calls v4 tilequery mapbox API
+	Tilequery(ctx context.Context, req *TilequeryRequest, opts ...CallOption) (*TilequeryResponse, error)
+}
+
+// FastHttpTilequery is a fasthttp Tilequery implementation
+type FastHttpTilequery struct {
+	config
+
+	tilesAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// Tilequery calls v4 tilequery mapbox API thought fasthttp client.
+func (c *FastHttpTilequery) Tilequery(ctx context.Context, req *TilequeryRequest, opts ...CallOption) (*TilequeryResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, 5)
+	if req.Radius > 0 {
+		values[radius] = strconv.FormatFloat(req.Radius, floatFormatNoExponent, -1, 64)
+	}
+	if req.Limit != 0 {
+		values[limit] = strconv.Itoa(req.Limit)
+	}
+	if len(req.Layers) > 0 {
+		values[layers] = strings.Join(req.Layers, ",")
+	}
+	if req.Dedupe != nil && !*req.Dedupe {
+		values[dedupe] = falseStr
+	}
+	if req.Geometry != "" {
+		values[geometry] = req.Geometry
+	}
+
+	tilesets := strings.Join(req.TilesetIDs, ",")
+	coordinate := req.GeoPoint.String()
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.tilesAPIURL.Write(buf, values, tilesets, "/tilequery/", coordinate, string(responseFormatJSON))
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "tilequery", reqURI, values, logKeyTilesets, tilesets, logKeyCoordinate, coordinate)
+
+	resp, err := c.do(ctx, "tilequery", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "tilequery", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("tilequery", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawTilequeryResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall tilequery resp %s: %w", string(resp.body), err)
+	}
+	for i := range respRaw.Features {
+		respRaw.Features[i].takeTilequeryInfo()
+	}
+
+	return &TilequeryResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Features:  respRaw.Features,
+	}, nil
+}
+
+func (req *TilequeryRequest) validate() error {
+	if len(req.TilesetIDs) == 0 {
+		return validationErrorf("TilesetIDs", ConstraintMinItems, "at least one tileset id is required")
+	}
+	if !validCoordinate(req.GeoPoint.Lon, 180) || !validCoordinate(req.GeoPoint.Lat, 90) {
+		return validationErrorf("GeoPoint", ConstraintRange, "invalid point %v,%v", req.GeoPoint.Lon, req.GeoPoint.Lat)
+	}
+	if req.Radius < 0 {
+		return validationErrorf("Radius", ConstraintRange, "invalid radius %v", req.Radius)
+	}
+	if req.Limit < 0 || req.Limit > maxTilequeryLimit {
+		return validationErrorf("Limit", ConstraintRange, "invalid limit %d, must be from 1 to %d", req.Limit, maxTilequeryLimit)
+	}
+	switch req.Geometry {
+	case "", TilequeryGeometryPolygon, TilequeryGeometryLinestring, TilequeryGeometryPoint:
+	default:
+		return validationErrorf("Geometry", ConstraintEnum, "unknown geometry %q", req.Geometry)
+	}
+	return nil
+}
+
+// takeTilequeryInfo moves tilequery property to Tilequery.
+func (f *TilequeryFeature) takeTilequeryInfo() {
+	info, ok := f.Properties[tilequeryProperty].(map[string]interface{})
+	if !ok {
+		return
+	}
+	delete(f.Properties, tilequeryProperty)
+
+	f.Tilequery.Distance, _ = info["distance"].(float64)
+	f.Tilequery.Geometry, _ = info["geometry"].(string)
+	f.Tilequery.Layer, _ = info["layer"].(string)
+}
+
+func NewFastHttpTilequery(opts ...Option) *FastHttpTilequery {
+	c := FastHttpTilequery{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.tilesAPIURL = c.endpointURL("/v4/")
+
+	return &c
+}
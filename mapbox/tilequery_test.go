@@ -0,0 +1,63 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpTilequery_Tilequery(t *testing.T) {
+	var gotURI string
+	c := NewFastHttpTilequery(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			gotURI = string(req.RequestURI())
+			resp.SetBodyString(`{"type":"FeatureCollection","features":[{"type":"Feature","id":1,` +
+				`"geometry":{"type":"Point","coordinates":[-122.42,37.77]},` +
+				`"properties":{"class":"park","type":"park","tilequery":{"distance":0,"geometry":"polygon","layer":"landuse"}}}]}`)
+			return nil
+		})))
+
+	dedupe := false
+	resp, err := c.Tilequery(context.Background(), &TilequeryRequest{
+		TilesetIDs: []string{"mapbox.mapbox-streets-v8"},
+		GeoPoint:   GeoPoint{Lon: -122.42, Lat: 37.77},
+		Radius:     10,
+		Limit:      3,
+		Layers:     []string{"landuse"},
+		Dedupe:     &dedupe,
+		Geometry:   TilequeryGeometryPolygon,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "https://api.mapbox.com/v4/mapbox.mapbox-streets-v8/tilequery/-122.420000,37.770000.json?access_token=token" +
+		"&dedupe=false&geometry=polygon&layers=landuse&limit=3&radius=10"
+	if gotURI != want {
+		t.Errorf("URI = %s, want %s", gotURI, want)
+	}
+	if len(resp.Features) != 1 {
+		t.Fatalf("unexpected features %+v", resp.Features)
+	}
+	f := resp.Features[0]
+	if f.Properties["class"] != "park" || f.Properties[tilequeryProperty] != nil || f.Geometry.Coordinates[0] != -122.42 {
+		t.Errorf("unexpected feature %+v", f)
+	}
+	if f.Tilequery != (TilequeryInfo{Geometry: TilequeryGeometryPolygon, Layer: "landuse"}) {
+		t.Errorf("unexpected tilequery info %+v", f.Tilequery)
+	}
+
+	invalid := []*TilequeryRequest{
+		{GeoPoint: GeoPoint{Lon: 1, Lat: 1}},
+		{TilesetIDs: []string{"mapbox.mapbox-streets-v8"}, GeoPoint: GeoPoint{Lon: 1, Lat: 91}},
+		{TilesetIDs: []string{"mapbox.mapbox-streets-v8"}, Limit: 51},
+		{TilesetIDs: []string{"mapbox.mapbox-streets-v8"}, Radius: -1},
+		{TilesetIDs: []string{"mapbox.mapbox-streets-v8"}, Geometry: "multipolygon"},
+	}
+	for _, req := range invalid {
+		if _, err := c.Tilequery(context.Background(), req); err == nil {
+			t.Errorf("Tilequery(%+v) error expected", req)
+		}
+	}
+}
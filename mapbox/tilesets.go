@@ -0,0 +1,261 @@
+package mapbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	typeParam  = "type"
+	visibility = "visibility"
+	sortby     = "sortby"
+	start      = "start"
+
+	// TilesetTypeVector filters vector tilesets.
+	TilesetTypeVector = "vector"
+	// TilesetTypeRaster filters raster tilesets.
+	TilesetTypeRaster = "raster"
+
+	// TilesetSortByCreated sorts tilesets by creation date, newest first.
+	TilesetSortByCreated = "created"
+	// TilesetSortByModified sorts tilesets by modification date, newest first.
+	TilesetSortByModified = "modified"
+
+	maxTilesetsLimit = 500
+)
+
+// ListTilesetsRequest describes list tilesets request.
+type ListTilesetsRequest struct {
+	// Username of the tilesets owner.
+	Username string
+	// Type filters tilesets by type: vector or raster.
+	Type string
+	// Visibility filters tilesets by visibility: public or private.
+	Visibility string
+	// SortBy sorts tilesets by created or modified date.
+	SortBy string
+	// Limit is a page size, from 1 to 500, default 100.
+	Limit int
+	// Start is a pagination key returned in ListTilesetsResponse.NextStart.
+	Start string
+}
+
+// Tileset describes a tileset without its data.
+type Tileset struct {
+	ID          string    `json:"id"`
+	Type        string    `json:"type"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Visibility  string    `json:"visibility"`
+	Status      string    `json:"status"`
+	Filesize    int64     `json:"filesize"`
+	Center      []float64 `json:"center"`
+	Created     time.Time `json:"created"`
+	Modified    time.Time `json:"modified"`
+}
+
+// easyjson:json
+type rawListTilesetsResp []Tileset
+
+// ListTilesetsResponse wraps a single page of tilesets.
+type ListTilesetsResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Tilesets []Tileset
+	// NextStart is a key of the next page, empty on the last page.
+	NextStart string
+}
+
+// Tilesets covers mapbox tilesets API.
+type Tilesets interface {
+	// ListTilesets calls tilesets/v1 list mapbox API
+	ListTilesets(ctx context.Context, req *ListTilesetsRequest, opts ...CallOption) (*ListTilesetsResponse, error)
+	// UploadTilesetSource calls tilesets/v1 sources mapbox API with line-delimited GeoJSON
+	UploadTilesetSource(ctx context.Context, req *TilesetSourceRequest, opts ...CallOption) (*TilesetSourceResponse, error)
+	// ValidateRecipe calls tilesets/v1 validateRecipe mapbox API
+	ValidateRecipe(ctx context.Context, recipe json.RawMessage, opts ...CallOption) (*RecipeValidationResponse, error)
+	// CreateTileset calls tilesets/v1 create mapbox API
+	CreateTileset(ctx context.Context, req *CreateTilesetRequest, opts ...CallOption) (*TilesetMessageResponse, error)
+	// PublishTileset calls tilesets/v1 publish mapbox API
+	PublishTileset(ctx context.Context, tilesetID string, opts ...CallOption) (*TilesetMessageResponse, error)
+	// TilesetJob calls tilesets/v1 job status mapbox API
+	TilesetJob(ctx context.Context, tilesetID, jobID string, opts ...CallOption) (*TilesetJobResponse, error)
+	// WaitForTilesetJob polls publish job until it succeeds or fails
+	WaitForTilesetJob(ctx context.Context, tilesetID, jobID string, opts ...CallOption) (*TilesetJob, error)
+}
+
+// FastHttpTilesets is a fasthttp Tilesets implementation
+type FastHttpTilesets struct {
+	config
+
+	tilesetsAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// ListTilesets calls tilesets/v1 list mapbox API thought fasthttp client.
+func (c *FastHttpTilesets) ListTilesets(ctx context.Context, req *ListTilesetsRequest, opts ...CallOption) (*ListTilesetsResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if req.Username == "" {
+		return nil, validationErrorf("Username", ConstraintRequired, "username is required")
+	}
+	if req.Limit < 0 || req.Limit > maxTilesetsLimit {
+		return nil, validationErrorf("Limit", ConstraintRange, "limit %d is out of range [1, %d]", req.Limit, maxTilesetsLimit)
+	}
+
+	values := make(map[string]string, 5)
+	if req.Type != "" {
+		values[typeParam] = req.Type
+	}
+	if req.Visibility != "" {
+		values[visibility] = req.Visibility
+	}
+	if req.SortBy != "" {
+		values[sortby] = req.SortBy
+	}
+	if req.Limit != 0 {
+		values[limit] = strconv.Itoa(req.Limit)
+	}
+	if req.Start != "" {
+		values[start] = url.QueryEscape(req.Start)
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.tilesetsAPIURL.Write(buf, values, req.Username)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "list tilesets", reqURI, values, logKeyUsername, req.Username)
+
+	resp, err := c.do(ctx, "list tilesets", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "list tilesets", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("list tilesets", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	respRaw := rawListTilesetsResp{}
+	if err := c.decode(resp, &respRaw); err != nil {
+		return nil, errorf("failed to unmarshall raw list tilesets resp %s: %w", string(resp.body), err)
+	}
+
+	return &ListTilesetsResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Tilesets:  respRaw,
+		NextStart: nextPageStart(resp.link),
+	}, nil
+}
+
+// TilesetsIterator walks through all pages of ListTilesets.
+//
+//	it := mapbox.NewTilesetsIterator(client, &mapbox.ListTilesetsRequest{Username: "user"})
+//	for it.Next(ctx) {
+//		ts := it.Tileset()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type TilesetsIterator struct {
+	tilesets Tilesets
+	req      ListTilesetsRequest
+
+	page []Tileset
+	i    int
+	last bool
+	err  error
+}
+
+// NewTilesetsIterator creates iterator starting from req.Start page.
+func NewTilesetsIterator(tilesets Tilesets, req *ListTilesetsRequest) *TilesetsIterator {
+	return &TilesetsIterator{
+		tilesets: tilesets,
+		req:      *req,
+		i:        -1,
+	}
+}
+
+// Next advances to the next tileset, fetching the next page if needed.
+// It returns false when there are no more tilesets or an error occurred.
+func (it *TilesetsIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.i++
+	for it.i >= len(it.page) {
+		if it.last {
+			return false
+		}
+
+		resp, err := it.tilesets.ListTilesets(ctx, &it.req)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page = resp.Tilesets
+		it.i = 0
+		it.req.Start = resp.NextStart
+		it.last = resp.NextStart == ""
+	}
+
+	return true
+}
+
+// Tileset returns current tileset.
+func (it *TilesetsIterator) Tileset() Tileset {
+	if it.i < 0 || it.i >= len(it.page) {
+		return Tileset{}
+	}
+	return it.page[it.i]
+}
+
+// Err returns the first error occurred while iterating.
+func (it *TilesetsIterator) Err() error {
+	return it.err
+}
+
+// nextPageStart extracts start param from `<url>; rel="next"` Link header.
+func nextPageStart(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 || !strings.Contains(segments[1], `rel="next"`) {
+			continue
+		}
+
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(segments[0]), "<>"))
+		if err != nil {
+			return ""
+		}
+		return u.Query().Get(start)
+	}
+
+	return ""
+}
+
+func NewFastHttpTilesets(opts ...Option) *FastHttpTilesets {
+	c := FastHttpTilesets{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.tilesetsAPIURL = c.endpointURL("/tilesets/v1/")
+
+	return &c
+}
@@ -0,0 +1,61 @@
+package mapbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestTilesetsIterator(t *testing.T) {
+	pages := map[string]string{
+		"":   `[{"id":"user.a","type":"vector"},{"id":"user.b","type":"vector"}]`,
+		"cb": `[{"id":"user.c","type":"raster"}]`,
+	}
+
+	c := NewFastHttpTilesets(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			uri := string(req.RequestURI())
+			page := ""
+			if strings.Contains(uri, "start=cb") {
+				page = "cb"
+			} else {
+				resp.Header.Set(respHeaderLink, `<https://api.mapbox.com/tilesets/v1/user?start=cb&limit=2>; rel="next"`)
+			}
+			if !strings.Contains(uri, "limit=2") {
+				t.Errorf("limit is not passed %s", uri)
+			}
+			resp.SetBodyString(pages[page])
+			return nil
+		})))
+
+	it := NewTilesetsIterator(c, &ListTilesetsRequest{Username: "user", Limit: 2})
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Tileset().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(ids, ",") != "user.a,user.b,user.c" {
+		t.Errorf("unexpected tilesets %v", ids)
+	}
+}
+
+func Test_nextPageStart(t *testing.T) {
+	tests := []struct {
+		link string
+		want string
+	}{
+		{link: "", want: ""},
+		{link: `<https://api.mapbox.com/tilesets/v1/user?start=abc>; rel="next"`, want: "abc"},
+		{link: `<https://api.mapbox.com/tilesets/v1/user?start=a>; rel="prev", <https://api.mapbox.com/tilesets/v1/user?start=b>; rel="next"`, want: "b"},
+	}
+	for _, tt := range tests {
+		if got := nextPageStart(tt.link); got != tt.want {
+			t.Errorf("nextPageStart(%q) = %q, want %q", tt.link, got, tt.want)
+		}
+	}
+}
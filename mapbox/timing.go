@@ -0,0 +1,45 @@
+package mapbox
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Timing is a call duration breakdown set with TimingBreakdown option,
+// so slow calls could be told apart from slow parsing.
+type Timing struct {
+	// Transport is the time the HTTP client took for the last attempt.
+	// fasthttp reads the whole body before returning and has no connection trace,
+	// so it covers DNS, connect, TLS, time to first byte and body read together.
+	Transport time.Duration
+	// Decode is the time SDK spent decoding JSON response, custom decoders are not included.
+	Decode time.Duration
+}
+
+// TimingBreakdown enables Meta.Timing, default false.
+func TimingBreakdown(enabled bool) Option {
+	return func(c config) config {
+		c.timing = enabled
+		return c
+	}
+}
+
+// decode unmarshals resp body into v measuring decode time if TimingBreakdown is enabled.
+func (c *config) decode(resp *rawResponse, v json.Unmarshaler) error {
+	done := c.timeDecode(resp)
+	defer done()
+
+	return v.UnmarshalJSON(resp.body)
+}
+
+// timeDecode starts measuring decode time, returned func stops it.
+func (c *config) timeDecode(resp *rawResponse) func() {
+	if !c.timing {
+		return func() {}
+	}
+
+	started := c.clock.Now()
+	return func() {
+		resp.meta.Timing.Decode += c.clock.Now().Sub(started)
+	}
+}
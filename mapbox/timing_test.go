@@ -0,0 +1,51 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// tickingClock advances by tick on every Now call.
+type tickingClock struct {
+	fakeClock
+	tick time.Duration
+}
+
+func (c *tickingClock) Now() time.Time {
+	c.now = c.now.Add(c.tick)
+	return c.now
+}
+
+func TestTimingBreakdown(t *testing.T) {
+	client := HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+		resp.SetBodyString(`[]`)
+		return nil
+	}))
+
+	tests := []struct {
+		name    string
+		enabled bool
+		want    Timing
+	}{
+		{name: "disabled"},
+		{name: "enabled", enabled: true, want: Timing{Transport: time.Second, Decode: time.Second}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &tickingClock{fakeClock: fakeClock{now: time.Unix(1000, 0)}, tick: time.Second}
+			s := NewFastHttpStyles(AccessToken("token"), WithClock(clock), TimingBreakdown(tt.enabled), client)
+
+			resp, err := s.ListStyles(context.Background(), &ListStylesRequest{Username: "user"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Meta.Timing != tt.want {
+				t.Errorf("Timing = %+v, want %+v", resp.Meta.Timing, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package mapbox
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// UploadStatus describes an upload job state.
+// easyjson:json
+type UploadStatus struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Tileset  string `json:"tileset"`
+	Owner    string `json:"owner"`
+	Complete bool   `json:"complete"`
+	// Error is set by mapbox if the upload failed.
+	Error string `json:"error"`
+	// Progress is a value from 0 to 1.
+	Progress float64   `json:"progress"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+}
+
+// ProgressPercent returns upload progress from 0 to 100.
+func (s *UploadStatus) ProgressPercent() float64 {
+	return s.Progress * 100
+}
+
+// UploadError is returned when mapbox reports an upload failure.
+type UploadError struct {
+	UploadID string
+	Message  string
+}
+
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("upload %s failed: %s", e.UploadID, e.Message)
+}
+
+// UploadStatusResponse wraps upload status.
+type UploadStatusResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+
+	Status UploadStatus
+}
+
+// Uploads covers mapbox uploads API.
+type Uploads interface {
+	// UploadStatus calls uploads/v1 status mapbox API
+	UploadStatus(ctx context.Context, uploadID string, opts ...CallOption) (*UploadStatusResponse, error)
+	// WaitForUpload polls upload status until it is complete or errored.
+	// onProgress, if not nil, is called after every poll.
+	WaitForUpload(ctx context.Context, uploadID string, onProgress func(*UploadStatus), opts ...CallOption) (*UploadStatus, error)
+}
+
+// FastHttpUploads is a fasthttp Uploads implementation
+type FastHttpUploads struct {
+	config
+
+	uploadsAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// UploadStatus calls uploads/v1 status mapbox API thought fasthttp client.
+func (c *FastHttpUploads) UploadStatus(ctx context.Context, uploadID string, opts ...CallOption) (*UploadStatusResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if uploadID == "" {
+		return nil, validationErrorf("uploadID", ConstraintRequired, "upload id is required")
+	}
+	if c.username == "" {
+		return nil, errors.New("username is required, set it with Username option")
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.uploadsAPIURL.Write(buf, nil, uploadID)
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "upload status", reqURI, nil, logKeyUpload, uploadID)
+
+	resp, err := c.do(ctx, "upload status", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "upload status", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("get upload status", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	status := UploadStatus{}
+	if err := c.decode(resp, &status); err != nil {
+		return nil, errorf("failed to unmarshall upload status resp %s: %w", string(resp.body), err)
+	}
+
+	return &UploadStatusResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		Status:    status,
+	}, nil
+}
+
+// WaitForUpload polls upload status every poll interval until it is complete or errored.
+// If mapbox reports an error, the last status is returned together with *UploadError.
+func (c *FastHttpUploads) WaitForUpload(ctx context.Context, uploadID string, onProgress func(*UploadStatus), opts ...CallOption) (*UploadStatus, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	ctx, cancel := c.life.bind(ctx)
+	defer cancel()
+
+	for {
+		resp, err := c.UploadStatus(ctx, uploadID)
+		if err != nil {
+			return nil, err
+		}
+
+		status := resp.Status
+		if onProgress != nil {
+			onProgress(&status)
+		}
+
+		if status.Error != "" {
+			return &status, &UploadError{UploadID: uploadID, Message: status.Error}
+		}
+		if status.Complete {
+			return &status, nil
+		}
+
+		if err := c.clock.Sleep(ctx, c.pollInterval); err != nil {
+			return &status, err
+		}
+	}
+}
+
+// usernameFromToken extracts username from mapbox token payload, e.g. pk.{"u":"username"}.signature.
+func usernameFromToken(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+
+	var claims struct {
+		U string `json:"u"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+
+	return claims.U
+}
+
+func NewFastHttpUploads(opts ...Option) *FastHttpUploads {
+	c := FastHttpUploads{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.uploadsAPIURL = c.endpointURL("/uploads/v1/", c.username, slash)
+
+	return &c
+}
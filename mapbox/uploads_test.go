@@ -0,0 +1,64 @@
+package mapbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpUploads_WaitForUpload(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []string
+		wantErr   bool
+		wantPolls int
+	}{
+		{
+			name: "complete",
+			responses: []string{
+				`{"id":"up","complete":false,"progress":0.5}`,
+				`{"id":"up","complete":true,"progress":1,"tileset":"user.tiles"}`,
+			},
+			wantPolls: 2,
+		},
+		{
+			name: "errored",
+			responses: []string{
+				`{"id":"up","complete":false,"progress":0.1,"error":"Invalid GeoJSON"}`,
+			},
+			wantErr:   true,
+			wantPolls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			c := NewFastHttpUploads(
+				AccessToken("sk.eyJ1IjoidXNlciJ9.sig"),
+				PollInterval(time.Millisecond),
+				HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+					if uri := string(req.RequestURI()); uri != "https://api.mapbox.com/uploads/v1/user/up?access_token=sk.eyJ1IjoidXNlciJ9.sig" {
+						t.Errorf("unexpected uri %s", uri)
+					}
+					resp.SetBodyString(tt.responses[calls])
+					calls++
+					return nil
+				})),
+			)
+
+			polls := 0
+			status, err := c.WaitForUpload(context.Background(), "up", func(*UploadStatus) { polls++ })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WaitForUpload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, ok := err.(*UploadError); tt.wantErr && !ok {
+				t.Errorf("unexpected error type %T", err)
+			}
+			if status == nil || polls != tt.wantPolls {
+				t.Errorf("unexpected status %+v after %d polls", status, polls)
+			}
+		})
+	}
+}
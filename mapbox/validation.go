@@ -0,0 +1,45 @@
+package mapbox
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Violated constraints of ValidationError.
+const (
+	ConstraintRequired  = "required"
+	ConstraintRange     = "range"
+	ConstraintMinItems  = "min_items"
+	ConstraintMaxItems  = "max_items"
+	ConstraintItems     = "items"
+	ConstraintMaxLength = "max_length"
+	ConstraintFormat    = "format"
+	ConstraintDuplicate = "duplicate"
+	ConstraintDepends   = "depends"
+	ConstraintEnum      = "enum"
+)
+
+// ValidationError is returned if a request is rejected before calling mapbox,
+// so API gateways could map it to 400 responses, e.g. with errors.As.
+type ValidationError struct {
+	// Field is a path of the invalid request field, e.g. Coordinates[2] or EV.MaxCharge.
+	// It is empty if the request is invalid as a whole, e.g. its URL is too long.
+	Field string
+	// Constraint is the violated one, e.g. ConstraintRange.
+	Constraint string
+	// Message is a human readable description.
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func validationErrorf(field, constraint, format string, args ...interface{}) *ValidationError {
+	return &ValidationError{Field: field, Constraint: constraint, Message: fmt.Sprintf(format, args...)}
+}
+
+// indexField returns path of i-th item of field list.
+func indexField(field string, i int) string {
+	return field + "[" + strconv.Itoa(i) + "]"
+}
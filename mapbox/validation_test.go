@@ -0,0 +1,101 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestValidationError(t *testing.T) {
+	ctx := context.Background()
+	d := NewFastHttpDirections(AccessToken("token"))
+	s := NewFastHttpStaticImages(PublicAccessToken("pk.public"))
+	points := []GeoPoint{{Lon: 1, Lat: 1}, {Lon: 2, Lat: 2}}
+
+	tests := []struct {
+		name           string
+		call           func() error
+		wantField      string
+		wantConstraint string
+	}{
+		{
+			name:           "coordinates count",
+			call:           func() error { return ValidateCoordinates(points[:1], MaxDirectionsCoordinates) },
+			wantField:      "Coordinates",
+			wantConstraint: ConstraintMinItems,
+		},
+		{
+			name: "coordinate range",
+			call: func() error {
+				return ValidateCoordinates([]GeoPoint{{Lon: 1, Lat: 1}, {Lon: 1, Lat: 91}}, MaxDirectionsCoordinates)
+			},
+			wantField:      "Coordinates[1]",
+			wantConstraint: ConstraintRange,
+		},
+		{
+			name: "waypoint list length",
+			call: func() error {
+				_, err := d.Directions(ctx, &DirectionsRequest{Coordinates: points, Approaches: []string{ApproachCurb}})
+				return err
+			},
+			wantField:      "Approaches",
+			wantConstraint: ConstraintItems,
+		},
+		{
+			name: "ev charge",
+			call: func() error {
+				_, err := d.Directions(ctx, &DirectionsRequest{Profile: ProfileDrivingTraffic, Coordinates: points,
+					EV: &EVParams{InitialCharge: 2, MaxCharge: 1}})
+				return err
+			},
+			wantField:      "EV.InitialCharge",
+			wantConstraint: ConstraintRange,
+		},
+		{
+			name: "static image size",
+			call: func() error {
+				return ValidateStaticImageRequest(&StaticImageRequest{StyleID: "s", Width: 1, Height: 2000})
+			},
+			wantField:      "Height",
+			wantConstraint: ConstraintRange,
+		},
+		{
+			name: "static image bbox",
+			call: func() error {
+				return ValidateStaticImageRequest(&StaticImageRequest{StyleID: "s", Width: 1, Height: 1, Bbox: []float64{1, 2}})
+			},
+			wantField:      "Bbox",
+			wantConstraint: ConstraintFormat,
+		},
+		{
+			name: "static image viewport",
+			call: func() error {
+				_, err := s.PublicStaticImageURL(ctx, &StaticImageRequest{StyleID: "s", Width: 1, Height: 1})
+				return err
+			},
+			wantField:      "Center",
+			wantConstraint: ConstraintRequired,
+		},
+		{
+			name: "parse swapped",
+			call: func() error {
+				_, err := ParseLonLat("38.9,-122.4")
+				return err
+			},
+			wantField:      "Lat",
+			wantConstraint: ConstraintRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var verr *ValidationError
+			if err := tt.call(); !errors.As(err, &verr) {
+				t.Fatalf("error = %v, want *ValidationError", err)
+			}
+			if verr.Field != tt.wantField || verr.Constraint != tt.wantConstraint {
+				t.Errorf("ValidationError = %+v, want field %s constraint %s", verr, tt.wantField, tt.wantConstraint)
+			}
+		})
+	}
+}
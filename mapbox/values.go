@@ -2,6 +2,7 @@ package mapbox
 
 import (
 	"bytes"
+	"sort"
 )
 
 const (
@@ -12,12 +13,41 @@ const (
 	ampersandMark = '&'
 )
 
-// encodeValues do almost the same as url.Values.Encode() but faster and reuses *strings.Builder
+// encodeValues do almost the same as url.Values.Encode() but faster and reuses *strings.Builder.
+// Values are sorted by key, so equal requests have equal URLs.
 func encodeValues(buf *bytes.Buffer, values map[string]string) {
-	for k, v := range values {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
 		buf.WriteByte(ampersandMark)
 		buf.WriteString(k)
 		buf.WriteByte(equalMark)
-		buf.WriteString(v)
+		buf.WriteString(values[k])
+	}
+}
+
+const upperHex = "0123456789ABCDEF"
+
+// writePathEscaped percent-encodes s as a single path segment, e.g. forward geocode search text,
+// like url.PathEscape but without allocations. Only RFC 3986 unreserved characters are written as is.
+func writePathEscaped(buf *bytes.Buffer, s string) {
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		if isUnreserved(b) {
+			buf.WriteByte(b)
+			continue
+		}
+		buf.WriteByte('%')
+		buf.WriteByte(upperHex[b>>4])
+		buf.WriteByte(upperHex[b&15])
 	}
 }
+
+func isUnreserved(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9' ||
+		b == '-' || b == '.' || b == '_' || b == '~'
+}
@@ -0,0 +1,247 @@
+package mapbox
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const (
+	secure = "secure"
+)
+
+var (
+	mvtFormat = []byte(".mvt")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+// TileJSONRequest describes TileJSON metadata request.
+type TileJSONRequest struct {
+	// TilesetIDs to retrieve metadata for, e.g. mapbox.mapbox-streets-v8.
+	// Multiple tilesets are composited into a single TileJSON.
+	TilesetIDs []string
+	// Secure forces https tile URLs in the response.
+	Secure bool
+}
+
+// VectorLayer describes a single layer of a vector tileset.
+type VectorLayer struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	MinZoom     int    `json:"minzoom"`
+	MaxZoom     int    `json:"maxzoom"`
+	Source      string `json:"source"`
+	SourceName  string `json:"source_name"`
+	// Fields maps attribute names to their types or descriptions.
+	Fields map[string]string `json:"fields"`
+}
+
+// TileJSON is a TileJSON v2 tileset metadata document.
+// easyjson:json
+type TileJSON struct {
+	TileJSON    string   `json:"tilejson"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Version     string   `json:"version"`
+	Attribution string   `json:"attribution"`
+	Scheme      string   `json:"scheme"`
+	Tiles       []string `json:"tiles"`
+	MinZoom     int      `json:"minzoom"`
+	MaxZoom     int      `json:"maxzoom"`
+	// Bounds in minLon,minLat,maxLon,maxLat order.
+	Bounds []float64 `json:"bounds"`
+	// Center as lon,lat,zoom.
+	Center       []float64     `json:"center"`
+	VectorLayers []VectorLayer `json:"vector_layers"`
+}
+
+// TileJSONResponse wraps TileJSON metadata.
+type TileJSONResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Raw mapbox API response
+	RawResp []byte
+	// Decoded is set instead of the fields below if CustomDecoder is registered for the endpoint
+	Decoded interface{}
+
+	TileJSON TileJSON
+}
+
+// VectorTileRequest describes a Mapbox Vector Tile.
+type VectorTileRequest struct {
+	// TilesetIDs of the tile, e.g. mapbox.mapbox-streets-v8.
+	// Multiple tilesets are composited into a single tile.
+	TilesetIDs []string
+	// Z is a zoom level from 0 to 22, X and Y are tile coordinates from 0 to 2^Z-1.
+	Z, X, Y int
+}
+
+// VectorTileResponse wraps vector tile.
+type VectorTileResponse struct {
+	RateLimit RateLimit
+	// Meta describes how the response was obtained
+	Meta Meta
+	// Cache headers of the tile.
+	Cache CacheHeaders
+	// Tile is the uncompressed protobuf encoded tile.
+	Tile []byte
+}
+
+// VectorTiles covers mapbox vector tiles API.
+type VectorTiles interface {
+	// TileJSON calls v4 TileJSON metadata mapbox API
+	TileJSON(ctx context.Context, req *TileJSONRequest, opts ...CallOption) (*TileJSONResponse, error)
+	// VectorTile calls v4 vector tiles mapbox API
+	VectorTile(ctx context.Context, req *VectorTileRequest, opts ...CallOption) (*VectorTileResponse, error)
+}
+
+// FastHttpVectorTiles is a fasthttp VectorTiles implementation
+type FastHttpVectorTiles struct {
+	config
+
+	tilesAPIURL EndpointURL
+
+	stringBufPull *stringsBufferPool
+}
+
+// TileJSON calls v4 TileJSON metadata mapbox API thought fasthttp client.
+func (c *FastHttpVectorTiles) TileJSON(ctx context.Context, req *TileJSONRequest, opts ...CallOption) (*TileJSONResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if len(req.TilesetIDs) == 0 {
+		return nil, validationErrorf("TilesetIDs", ConstraintMinItems, "at least one tileset id is required")
+	}
+
+	values := make(map[string]string, 1)
+	if req.Secure {
+		values[secure] = trueStr
+	}
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	c.tilesAPIURL.Write(buf, values, strings.Join(req.TilesetIDs, ","), string(responseFormatJSON))
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "tilejson", reqURI, values, logKeyTilesets, strings.Join(req.TilesetIDs, ","))
+
+	resp, err := c.do(ctx, "tilejson", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logResponse(ctx, "tilejson", resp.statusCode, resp.meta.RequestID, resp.body)
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("get tilejson", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	if decoded, ok, err := c.customDecode(EndpointTileJSON, resp.body); ok {
+		if err != nil {
+			return nil, err
+		}
+		return &TileJSONResponse{
+			RateLimit: resp.rateLimit,
+			Meta:      resp.meta,
+			RawResp:   resp.body,
+			Decoded:   decoded,
+		}, nil
+	}
+
+	tj := TileJSON{}
+	if err := c.decode(resp, &tj); err != nil {
+		return nil, errorf("failed to unmarshall tilejson resp %s: %w", string(resp.body), err)
+	}
+
+	return &TileJSONResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		RawResp:   resp.body,
+		TileJSON:  tj,
+	}, nil
+}
+
+// VectorTile calls v4 vector tiles mapbox API thought fasthttp client.
+// Gzip compressed tiles are decompressed.
+func (c *FastHttpVectorTiles) VectorTile(ctx context.Context, req *VectorTileRequest, opts ...CallOption) (*VectorTileResponse, error) {
+	ctx = withCallOptions(ctx, opts)
+
+	if len(req.TilesetIDs) == 0 {
+		return nil, validationErrorf("TilesetIDs", ConstraintMinItems, "at least one tileset id is required")
+	}
+	if err := validateTile(req.Z, req.X, req.Y); err != nil {
+		return nil, err
+	}
+
+	tilesets := strings.Join(req.TilesetIDs, ",")
+
+	buf := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(buf)
+
+	path := c.stringBufPull.acquireStringsBuilder()
+	defer c.stringBufPull.releaseStringsBuilder(path)
+
+	path.WriteString(tilesets)
+	path.WriteString(slash)
+	writeTilePath(path, req.Z, req.X, req.Y)
+	path.Write(mvtFormat)
+
+	c.tilesAPIURL.Write(buf, nil, path.String())
+
+	reqURI := buf.Bytes()
+
+	c.logRequest(ctx, "vector tile", reqURI, nil, logKeyTilesets, tilesets)
+
+	resp, err := c.do(ctx, "vector tile", getMethod, reqURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.withLogger(ctx, func(logger Logger) {
+		logger.Debugf("mapbox_sdk: vector tile response status=%d bytes=%d", resp.statusCode, len(resp.body))
+	})
+
+	if resp.statusCode != http.StatusOK {
+		return nil, newStatusError("get vector tile", string(reqURI), resp.statusCode, resp.meta.RequestID, resp.body)
+	}
+
+	tile := resp.body
+	if bytes.HasPrefix(tile, gzipMagic) {
+		if tile, err = gunzip(tile); err != nil {
+			return nil, errorf("failed to decompress vector tile %s: %w", c.redactURI(reqURI), err)
+		}
+	}
+
+	return &VectorTileResponse{
+		RateLimit: resp.rateLimit,
+		Meta:      resp.meta,
+		Cache:     resp.cache,
+		Tile:      tile,
+	}, nil
+}
+
+func gunzip(b []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+func NewFastHttpVectorTiles(opts ...Option) *FastHttpVectorTiles {
+	c := FastHttpVectorTiles{
+		config:        build(opts),
+		stringBufPull: newStringsBufferPool(),
+	}
+	c.tilesAPIURL = c.endpointURL("/v4/")
+
+	return &c
+}
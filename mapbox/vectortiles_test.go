@@ -0,0 +1,82 @@
+package mapbox
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestFastHttpVectorTiles_TileJSON(t *testing.T) {
+	c := NewFastHttpVectorTiles(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			if uri := string(req.RequestURI()); uri != "https://api.mapbox.com/v4/mapbox.mapbox-streets-v8,mapbox.mapbox-terrain-v2.json?access_token=token" {
+				t.Errorf("unexpected uri %s", uri)
+			}
+			resp.SetBodyString(`{"tilejson":"2.2.0","bounds":[-180,-85,180,85],"minzoom":0,"maxzoom":16,
+				"vector_layers":[{"id":"landuse","minzoom":5,"maxzoom":16,"fields":{"class":"String"}}]}`)
+			return nil
+		})))
+
+	resp, err := c.TileJSON(context.Background(), &TileJSONRequest{
+		TilesetIDs: []string{"mapbox.mapbox-streets-v8", "mapbox.mapbox-terrain-v2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tj := resp.TileJSON
+	if tj.MaxZoom != 16 || len(tj.Bounds) != 4 || len(tj.VectorLayers) != 1 || tj.VectorLayers[0].Fields["class"] != "String" {
+		t.Errorf("unexpected tilejson %+v", tj)
+	}
+}
+
+func TestFastHttpVectorTiles_VectorTile(t *testing.T) {
+	tile := []byte{0x1a, 0x02, 0x78, 0x02}
+	gz := bytes.Buffer{}
+	w := gzip.NewWriter(&gz)
+	w.Write(tile)
+	w.Close()
+
+	var gotURI string
+	compressed := true
+	c := NewFastHttpVectorTiles(AccessToken("token"), HttpClient(fastHttpClientFunc(
+		func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			gotURI = string(req.RequestURI())
+			resp.Header.Set("Cache-Control", "max-age=43200")
+			if compressed {
+				resp.Header.Set("Content-Encoding", "gzip")
+				resp.SetBody(gz.Bytes())
+			} else {
+				resp.SetBody(tile)
+			}
+			return nil
+		})))
+
+	req := &VectorTileRequest{TilesetIDs: []string{"mapbox.mapbox-streets-v8", "mapbox.mapbox-terrain-v2"}, Z: 14, X: 4823, Y: 6160}
+	for _, compressed = range []bool{true, false} {
+		resp, err := c.VectorTile(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "https://api.mapbox.com/v4/mapbox.mapbox-streets-v8,mapbox.mapbox-terrain-v2/14/4823/6160.mvt?access_token=token"; gotURI != want {
+			t.Errorf("URI = %s, want %s", gotURI, want)
+		}
+		if !bytes.Equal(resp.Tile, tile) || resp.Cache.CacheControl != "max-age=43200" {
+			t.Errorf("unexpected response %+v", resp)
+		}
+	}
+
+	invalid := []*VectorTileRequest{
+		{Z: 1},
+		{TilesetIDs: []string{"mapbox.mapbox-streets-v8"}, Z: -1},
+		{TilesetIDs: []string{"mapbox.mapbox-streets-v8"}, Z: 2, Y: 4},
+	}
+	for _, req := range invalid {
+		if _, err := c.VectorTile(context.Background(), req); err == nil {
+			t.Errorf("VectorTile(%+v) error expected", req)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package mapbox
+
+import (
+	"context"
+)
+
+var headMethod = []byte("HEAD")
+
+// Warmup sends HEAD request to RootAPI and failover root apis, so DNS is resolved and TLS connections
+// are kept alive in the http client pool before the first call after deploy.
+// Any response status is fine, only transport errors are returned.
+func (c *config) Warmup(ctx context.Context) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	if !c.life.acquire() {
+		return ErrClosed
+	}
+	defer c.life.release()
+
+	hosts := []string{c.rootAPI}
+	if c.hosts != nil {
+		hosts = c.hosts.hosts
+	}
+
+	for _, h := range hosts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := c.doOnce(ctx, "warmup", headMethod, []byte(h+slash), nil, ""); err != nil {
+			return errorf("failed to warmup %s: %w", h, err)
+		}
+	}
+
+	return nil
+}
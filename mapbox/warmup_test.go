@@ -0,0 +1,36 @@
+package mapbox
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestWarmup(t *testing.T) {
+	var requests []string
+	c := NewFastHttpGeocoder(
+		RootAPI("https://primary"),
+		Failover(FailoverOptions{RootAPIs: []string{"https://proxy"}}),
+		HttpClient(fastHttpClientFunc(func(req *fasthttp.Request, resp *fasthttp.Response) error {
+			requests = append(requests, string(req.Header.Method())+" "+string(req.RequestURI()))
+			if string(req.RequestURI()) == "https://proxy/" {
+				return errors.New("no such host")
+			}
+			resp.SetStatusCode(fasthttp.StatusNotFound)
+			return nil
+		})),
+	)
+
+	err := c.Warmup(context.Background())
+	if err == nil || err.Error() != "failed to warmup https://proxy: no such host" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	want := []string{"HEAD https://primary/", "HEAD https://proxy/"}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}